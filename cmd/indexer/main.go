@@ -4,6 +4,8 @@ import (
 	"context"
 	"log"
 	"net"
+	"os"
+	"strconv"
 
 	"Kumazan/go-ethereum-server/db"
 	"Kumazan/go-ethereum-server/pkg/grpc"
@@ -14,6 +16,13 @@ import (
 
 const (
 	port = ":5001"
+
+	signaturesPath = "db/signatures.txt"
+)
+
+var (
+	backfillStart  = os.Getenv("BACKFILL_START_BLOCK")
+	signaturesFile = os.Getenv("SIGNATURES_FILE")
 )
 
 func main() {
@@ -22,6 +31,27 @@ func main() {
 	go func() {
 		service.RetrieveBlocks(context.Background())
 	}()
+	go func() {
+		service.RepairTransactions(context.Background())
+	}()
+	go func() {
+		service.DecodeLogs(context.Background())
+	}()
+	go func() {
+		importSignatures(service, signaturesPath)
+		if signaturesFile != "" {
+			importSignatures(service, signaturesFile)
+		}
+	}()
+	if backfillStart != "" {
+		fromNum, err := strconv.ParseUint(backfillStart, 10, 64)
+		if err != nil {
+			log.Fatalf("BACKFILL_START_BLOCK is invalid: %v", err)
+		}
+		go func() {
+			service.Backfill(context.Background(), fromNum)
+		}()
+	}
 	server := grpc.NewServer(service)
 
 	lis, err := net.Listen("tcp", port)
@@ -32,3 +62,21 @@ func main() {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// importSignatures loads the text signatures in the file at path into the
+// signature database.
+func importSignatures(service service.EthereumService, path string) {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Printf("os.Open failed: %+v", err)
+		return
+	}
+	defer f.Close()
+
+	count, err := service.ImportSignatures(context.Background(), f)
+	if err != nil {
+		log.Printf("ImportSignatures %s failed: %+v", path, err)
+		return
+	}
+	log.Printf("imported %d signatures from %s", count, path)
+}
@@ -2,14 +2,28 @@ package main
 
 import (
 	"log"
+	"os"
+	"strings"
 
 	"Kumazan/go-ethereum-server/pkg/grpc"
+	"Kumazan/go-ethereum-server/pkg/node"
 	"Kumazan/go-ethereum-server/pkg/router"
 )
 
 func main() {
 	grpcClient := grpc.NewClient()
-	router := router.New(grpcClient)
+
+	// JSON-RPC methods the index does not answer are proxied to RPC_ENDPOINT
+	var upstream *node.Pool
+	if endpoints := os.Getenv("RPC_ENDPOINT"); endpoints != "" {
+		var err error
+		upstream, err = node.Dial(strings.Split(endpoints, ","), 1)
+		if err != nil {
+			log.Fatalf("node.Dial failed: %+v", err)
+		}
+	}
+
+	router := router.New(grpcClient, upstream)
 	if err := router.Engine.Run(); err != nil {
 		log.Fatalf("failed to run: %v", err)
 	}
@@ -0,0 +1 @@
+ALTER TABLE "transactions" ALTER COLUMN "data" TYPE VARCHAR(1024) USING LEFT("data", 1024);
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE "transactions" ALTER COLUMN "data" TYPE TEXT;
+-- the input data was stored as a 32-byte hash-shaped value, so only those
+-- rows are cleared for the indexer to re-fetch
+UPDATE "transactions" SET "data" = NULL WHERE LENGTH("data") = 66;
\ No newline at end of file
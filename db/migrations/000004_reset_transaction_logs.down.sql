@@ -0,0 +1,2 @@
+-- irreversible: the truncated logs cleared by the up migration are gone, and
+-- the rows re-fetched since then hold the complete logs
\ No newline at end of file
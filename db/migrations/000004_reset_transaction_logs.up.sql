@@ -0,0 +1 @@
+UPDATE "transactions" SET "logs" = 'null'::JSON;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE "transactions" ADD COLUMN "logs" JSON;
+ALTER TABLE "transactions" DROP COLUMN "logs_indexed";
+DROP TABLE IF EXISTS "logs";
\ No newline at end of file
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS "logs" (
+    "tx_hash" VARCHAR(66) NOT NULL,
+    "log_index" INTEGER NOT NULL,
+    "block_num" INTEGER NOT NULL,
+    "block_hash" VARCHAR(66) NOT NULL,
+    "tx_index" INTEGER NOT NULL,
+    "address" VARCHAR(42) NOT NULL,
+    "topic0" VARCHAR(66),
+    "topic1" VARCHAR(66),
+    "topic2" VARCHAR(66),
+    "topic3" VARCHAR(66),
+    "data" TEXT NOT NULL,
+    "removed" BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY ("tx_hash", "log_index")
+);
+CREATE INDEX IF NOT EXISTS "logs_block_num_idx" ON "logs" ("block_num");
+CREATE INDEX IF NOT EXISTS "logs_address_block_num_idx" ON "logs" ("address", "block_num");
+CREATE INDEX IF NOT EXISTS "logs_topic0_block_num_idx" ON "logs" ("topic0", "block_num");
+CREATE INDEX IF NOT EXISTS "logs_topic1_block_num_idx" ON "logs" ("topic1", "block_num");
+CREATE INDEX IF NOT EXISTS "logs_topic2_block_num_idx" ON "logs" ("topic2", "block_num");
+CREATE INDEX IF NOT EXISTS "logs_topic3_block_num_idx" ON "logs" ("topic3", "block_num");
+
+INSERT INTO "logs" ("tx_hash", "log_index", "block_num", "block_hash", "tx_index", "address",
+    "topic0", "topic1", "topic2", "topic3", "data", "removed")
+SELECT t.tx_hash, (l->>'index')::INTEGER, (l->>'block_num')::INTEGER, l->>'block_hash',
+    (l->>'tx_index')::INTEGER, l->>'address',
+    l->'topics'->>0, l->'topics'->>1, l->'topics'->>2, l->'topics'->>3,
+    l->>'data', (l->>'removed')::BOOLEAN
+FROM "transactions" t,
+    json_array_elements(CASE WHEN json_typeof(t.logs) = 'array' THEN t.logs ELSE '[]'::JSON END) l
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE "transactions" ADD COLUMN "logs_indexed" BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE "transactions" SET "logs_indexed" = TRUE WHERE json_typeof("logs") = 'array';
+ALTER TABLE "transactions" DROP COLUMN "logs";
\ No newline at end of file
@@ -0,0 +1,8 @@
+ALTER TABLE "transactions"
+    DROP COLUMN "type",
+    DROP COLUMN "gas",
+    DROP COLUMN "gas_price",
+    DROP COLUMN "max_fee_per_gas",
+    DROP COLUMN "max_priority_fee_per_gas",
+    DROP COLUMN "access_list",
+    DROP COLUMN "blob_hashes";
\ No newline at end of file
@@ -0,0 +1,8 @@
+ALTER TABLE "transactions"
+    ADD COLUMN "type" SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN "gas" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN "gas_price" VARCHAR(78),
+    ADD COLUMN "max_fee_per_gas" VARCHAR(78),
+    ADD COLUMN "max_priority_fee_per_gas" VARCHAR(78),
+    ADD COLUMN "access_list" JSON,
+    ADD COLUMN "blob_hashes" JSON;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE "transactions" ADD COLUMN "logs_indexed" BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE "transactions" SET "logs_indexed" = TRUE WHERE "tx_hash" IN (SELECT "tx_hash" FROM "receipts");
+DROP TABLE IF EXISTS "receipts";
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "receipts" (
+    "tx_hash" VARCHAR(66) PRIMARY KEY,
+    "block_num" INTEGER NOT NULL,
+    "block_hash" VARCHAR(66) NOT NULL,
+    "tx_index" INTEGER NOT NULL,
+    "type" SMALLINT NOT NULL,
+    "status" SMALLINT NOT NULL,
+    "cumulative_gas_used" BIGINT NOT NULL,
+    "gas_used" BIGINT NOT NULL,
+    "effective_gas_price" VARCHAR(78),
+    "contract_address" VARCHAR(42)
+);
+CREATE INDEX IF NOT EXISTS "receipts_block_num_idx" ON "receipts" ("block_num");
+
+ALTER TABLE "transactions" DROP COLUMN "logs_indexed";
\ No newline at end of file
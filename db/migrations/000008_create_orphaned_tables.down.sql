@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "orphaned_transactions";
+DROP TABLE IF EXISTS "orphaned_blocks";
\ No newline at end of file
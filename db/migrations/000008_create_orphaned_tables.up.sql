@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "orphaned_blocks" (
+    "block_hash" VARCHAR(66) PRIMARY KEY,
+    "block_num" INTEGER NOT NULL,
+    "block_time" INTEGER NOT NULL,
+    "parent_hash" VARCHAR(66) NOT NULL,
+    "orphaned_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS "orphaned_blocks_block_num_idx" ON "orphaned_blocks" ("block_num");
+
+CREATE TABLE IF NOT EXISTS "orphaned_transactions" (
+    "tx_hash" VARCHAR(66) NOT NULL,
+    "block_hash" VARCHAR(66) NOT NULL REFERENCES orphaned_blocks(block_hash),
+    "block_num" INTEGER NOT NULL,
+    "tx" JSON NOT NULL,
+    PRIMARY KEY ("tx_hash", "block_hash")
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "checkpoints";
\ No newline at end of file
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS "checkpoints" (
+    "name" VARCHAR(64) PRIMARY KEY,
+    "block_num" INTEGER NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
\ No newline at end of file
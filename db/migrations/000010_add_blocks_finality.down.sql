@@ -0,0 +1,2 @@
+ALTER TABLE "blocks"
+    DROP COLUMN "finality";
\ No newline at end of file
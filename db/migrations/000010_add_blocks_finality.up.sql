@@ -0,0 +1,2 @@
+ALTER TABLE "blocks"
+    ADD COLUMN "finality" VARCHAR(16) NOT NULL DEFAULT 'latest';
\ No newline at end of file
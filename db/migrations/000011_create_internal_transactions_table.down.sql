@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "internal_transactions";
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "internal_transactions" (
+    "tx_hash" VARCHAR(66) NOT NULL,
+    "trace_index" INTEGER NOT NULL,
+    "block_num" INTEGER NOT NULL,
+    "type" VARCHAR(16) NOT NULL,
+    "from_addr" VARCHAR(42) NOT NULL,
+    "to_addr" VARCHAR(42),
+    "value" VARCHAR(78) NOT NULL,
+    "gas" BIGINT NOT NULL,
+    "gas_used" BIGINT NOT NULL,
+    "depth" INTEGER NOT NULL,
+    "error" TEXT,
+    PRIMARY KEY ("tx_hash", "trace_index")
+);
+CREATE INDEX IF NOT EXISTS "internal_transactions_block_num_idx" ON "internal_transactions" ("block_num");
\ No newline at end of file
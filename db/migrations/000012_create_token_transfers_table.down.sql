@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "token_transfers";
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "token_transfers" (
+    "tx_hash" VARCHAR(66) NOT NULL,
+    "log_index" INTEGER NOT NULL,
+    "block_num" INTEGER NOT NULL,
+    "token" VARCHAR(42) NOT NULL,
+    "from_addr" VARCHAR(42) NOT NULL,
+    "to_addr" VARCHAR(42) NOT NULL,
+    "amount" VARCHAR(78) NOT NULL,
+    PRIMARY KEY ("tx_hash", "log_index")
+);
+CREATE INDEX IF NOT EXISTS "token_transfers_block_num_idx" ON "token_transfers" ("block_num");
+CREATE INDEX IF NOT EXISTS "token_transfers_token_idx" ON "token_transfers" ("token", "block_num");
+CREATE INDEX IF NOT EXISTS "token_transfers_from_addr_idx" ON "token_transfers" ("from_addr", "block_num");
+CREATE INDEX IF NOT EXISTS "token_transfers_to_addr_idx" ON "token_transfers" ("to_addr", "block_num");
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "nft_transfers";
\ No newline at end of file
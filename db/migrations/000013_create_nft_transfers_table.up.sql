@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "nft_transfers" (
+    "tx_hash" VARCHAR(66) NOT NULL,
+    "log_index" INTEGER NOT NULL,
+    "batch_index" INTEGER NOT NULL,
+    "block_num" INTEGER NOT NULL,
+    "standard" VARCHAR(8) NOT NULL,
+    "token" VARCHAR(42) NOT NULL,
+    "operator" VARCHAR(42),
+    "from_addr" VARCHAR(42) NOT NULL,
+    "to_addr" VARCHAR(42) NOT NULL,
+    "token_id" VARCHAR(78) NOT NULL,
+    "amount" VARCHAR(78) NOT NULL,
+    PRIMARY KEY ("tx_hash", "log_index", "batch_index")
+);
+CREATE INDEX IF NOT EXISTS "nft_transfers_block_num_idx" ON "nft_transfers" ("block_num");
+CREATE INDEX IF NOT EXISTS "nft_transfers_token_idx" ON "nft_transfers" ("token", "token_id");
+CREATE INDEX IF NOT EXISTS "nft_transfers_from_addr_idx" ON "nft_transfers" ("from_addr");
+CREATE INDEX IF NOT EXISTS "nft_transfers_to_addr_idx" ON "nft_transfers" ("to_addr");
+
+-- decode the stored logs again to find their NFT transfers
+DELETE FROM "checkpoints" WHERE "name" = 'decode-logs';
\ No newline at end of file
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS "contract_abis" (
+    "address" VARCHAR(42) PRIMARY KEY,
+    "abi" JSON NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "signatures";
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS "signatures" (
+    "signature" TEXT PRIMARY KEY,
+    "selector" VARCHAR(10) NOT NULL,
+    "topic" VARCHAR(66) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS "signatures_selector_idx" ON "signatures" ("selector");
+CREATE INDEX IF NOT EXISTS "signatures_topic_idx" ON "signatures" ("topic");
\ No newline at end of file
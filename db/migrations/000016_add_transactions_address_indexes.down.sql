@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "transactions_from_addr_idx";
+DROP INDEX IF EXISTS "transactions_to_addr_idx";
\ No newline at end of file
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS "transactions_from_addr_idx" ON "transactions" ("from_addr", "block_num");
+CREATE INDEX IF NOT EXISTS "transactions_to_addr_idx" ON "transactions" ("to_addr", "block_num");
\ No newline at end of file
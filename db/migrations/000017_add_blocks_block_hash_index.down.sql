@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "blocks_block_hash_idx";
\ No newline at end of file
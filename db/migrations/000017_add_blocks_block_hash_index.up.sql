@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS "blocks_block_hash_idx" ON "blocks" ("block_hash");
\ No newline at end of file
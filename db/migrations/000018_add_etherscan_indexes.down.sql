@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "blocks_block_time_idx";
+DROP INDEX IF EXISTS "internal_transactions_from_addr_idx";
+DROP INDEX IF EXISTS "internal_transactions_to_addr_idx";
\ No newline at end of file
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS "blocks_block_time_idx" ON "blocks" ("block_time");
+CREATE INDEX IF NOT EXISTS "internal_transactions_from_addr_idx" ON "internal_transactions" ("from_addr", "block_num");
+CREATE INDEX IF NOT EXISTS "internal_transactions_to_addr_idx" ON "internal_transactions" ("to_addr", "block_num");
\ No newline at end of file
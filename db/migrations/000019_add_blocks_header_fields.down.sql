@@ -0,0 +1,21 @@
+ALTER TABLE "blocks"
+    DROP COLUMN "miner",
+    DROP COLUMN "difficulty",
+    DROP COLUMN "gas_limit",
+    DROP COLUMN "gas_used",
+    DROP COLUMN "base_fee",
+    DROP COLUMN "extra_data",
+    DROP COLUMN "mix_hash",
+    DROP COLUMN "nonce",
+    DROP COLUMN "uncles_hash",
+    DROP COLUMN "state_root",
+    DROP COLUMN "transactions_root",
+    DROP COLUMN "receipts_root",
+    DROP COLUMN "logs_bloom",
+    DROP COLUMN "withdrawals_root",
+    DROP COLUMN "blob_gas_used",
+    DROP COLUMN "excess_blob_gas",
+    DROP COLUMN "parent_beacon_root",
+    DROP COLUMN "size",
+    DROP COLUMN "uncles",
+    DROP COLUMN "withdrawals";
\ No newline at end of file
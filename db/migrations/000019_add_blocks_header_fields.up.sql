@@ -0,0 +1,21 @@
+ALTER TABLE "blocks"
+    ADD COLUMN "miner" VARCHAR(42),
+    ADD COLUMN "difficulty" VARCHAR(78),
+    ADD COLUMN "gas_limit" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN "gas_used" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN "base_fee" VARCHAR(78),
+    ADD COLUMN "extra_data" TEXT,
+    ADD COLUMN "mix_hash" VARCHAR(66),
+    ADD COLUMN "nonce" VARCHAR(18),
+    ADD COLUMN "uncles_hash" VARCHAR(66),
+    ADD COLUMN "state_root" VARCHAR(66),
+    ADD COLUMN "transactions_root" VARCHAR(66),
+    ADD COLUMN "receipts_root" VARCHAR(66),
+    ADD COLUMN "logs_bloom" VARCHAR(514),
+    ADD COLUMN "withdrawals_root" VARCHAR(66),
+    ADD COLUMN "blob_gas_used" BIGINT,
+    ADD COLUMN "excess_blob_gas" BIGINT,
+    ADD COLUMN "parent_beacon_root" VARCHAR(66),
+    ADD COLUMN "size" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN "uncles" JSON,
+    ADD COLUMN "withdrawals" JSON;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE "transactions"
+    DROP COLUMN "chain_id",
+    DROP COLUMN "max_fee_per_blob_gas",
+    DROP COLUMN "v",
+    DROP COLUMN "r",
+    DROP COLUMN "s";
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE "transactions"
+    ADD COLUMN "chain_id" VARCHAR(78),
+    ADD COLUMN "max_fee_per_blob_gas" VARCHAR(78),
+    ADD COLUMN "v" VARCHAR(78),
+    ADD COLUMN "r" VARCHAR(78),
+    ADD COLUMN "s" VARCHAR(78);
\ No newline at end of file
@@ -2,12 +2,17 @@ package grpc
 
 import (
 	"context"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"Kumazan/go-ethereum-server/pb"
+	"Kumazan/go-ethereum-server/pkg/model"
 	"Kumazan/go-ethereum-server/pkg/service"
 )
 
@@ -17,6 +22,24 @@ type EthereumServer struct {
 	svc service.EthereumService
 }
 
+const (
+	// maxListSize caps the hashes and numbers looked up by a single list
+	// request.
+	maxListSize = 10000
+
+	// the largest pages served, those the REST server asks for: up to 10000
+	// results of an etherscan listing, and a log over the 10000 of
+	// eth_getLogs to tell that a query returns more
+	maxBlocksLimit  = 1024
+	maxResultsLimit = 10000
+	maxLogsLimit    = 10000 + 1
+)
+
+// validLimit reports whether limit is a page size from 1 to max.
+func validLimit(limit int32, max int) bool {
+	return limit > 0 && int(limit) <= max
+}
+
 func NewServer(svc service.EthereumService) *EthereumServer {
 	grpcServer := grpc.NewServer()
 	s := &EthereumServer{Server: grpcServer, svc: svc}
@@ -25,25 +48,150 @@ func NewServer(svc service.EthereumService) *EthereumServer {
 }
 
 func (s *EthereumServer) ListLastestBlocks(ctx context.Context, req *pb.ListLastestBlocksRequest) (*pb.ListLastestBlocksResponse, error) {
-	blocks, err := s.svc.ListLastestBlocks(ctx, int(req.Limit))
+	if req.Finality != "" && !model.IsFinality(req.Finality) {
+		return &pb.ListLastestBlocksResponse{}, status.Error(codes.InvalidArgument, "finality is invalid")
+	}
+	if !validLimit(req.Limit, maxBlocksLimit) {
+		return &pb.ListLastestBlocksResponse{}, status.Error(codes.InvalidArgument, "limit is invalid")
+	}
+	cursor, err := model.DecodeCursor(req.PageToken)
+	if err != nil {
+		return &pb.ListLastestBlocksResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	blocks, err := s.svc.ListLastestBlocks(ctx, int(req.Limit), req.Finality, cursor)
 	if err != nil {
 		return &pb.ListLastestBlocksResponse{}, err
 	}
 
 	res := make([]*pb.Block, len(blocks))
+	keys := make([]model.Cursor, len(blocks))
 	for i, b := range blocks {
-		res[i] = &pb.Block{
-			BlockNum:   int64(b.BlockNum),
-			BlockHash:  b.BlockHash,
-			BlockTime:  int64(b.BlockTime),
-			ParentHash: b.ParentHash,
+		res[i] = newPbBlockHeader(b)
+		keys[i] = model.Cursor{BlockNum: b.BlockNum}
+	}
+	next, prev := pageTokens(cursor, int(req.Limit), keys)
+	return &pb.ListLastestBlocksResponse{Blocks: res, NextPageToken: next, PrevPageToken: prev}, nil
+}
+
+// pageTokens returns the tokens to the pages around a page read from cursor,
+// given the positions of its entries, newest first. next continues in the
+// direction of the page and prev turns back. Walking backwards, next is empty
+// once a short page reached the oldest entry. Walking forwards, next is always
+// set, repeating the cursor after an empty page, so clients can poll it for
+// new entries.
+func pageTokens(cursor *model.Cursor, limit int, keys []model.Cursor) (next, prev string) {
+	forward := cursor != nil && cursor.Forward
+	if len(keys) == 0 {
+		if forward {
+			next = cursor.Encode()
 		}
+		return next, ""
 	}
-	return &pb.ListLastestBlocksResponse{Blocks: res}, nil
+
+	newest, oldest := keys[0], keys[len(keys)-1]
+	newest.Forward, oldest.Forward = true, false
+	if forward {
+		return newest.Encode(), oldest.Encode()
+	}
+	if len(keys) == limit {
+		next = oldest.Encode()
+	}
+	return next, newest.Encode()
+}
+
+// blockNumber returns the number of an optional block number field, or nil
+// for the latest block when it is unset.
+func blockNumber(num *wrapperspb.UInt64Value) *uint64 {
+	if num == nil {
+		return nil
+	}
+	n := num.Value
+	return &n
+}
+
+func (s *EthereumServer) ListBlocks(ctx context.Context, req *pb.ListBlocksRequest) (*pb.ListBlocksResponse, error) {
+	if req.Finality != "" && !model.IsFinality(req.Finality) {
+		return &pb.ListBlocksResponse{}, status.Error(codes.InvalidArgument, "finality is invalid")
+	}
+	if req.FromBlock < 0 {
+		return &pb.ListBlocksResponse{}, status.Error(codes.InvalidArgument, "from_block is invalid")
+	}
+	toBlock := blockNumber(req.ToBlock)
+	if toBlock != nil && *toBlock < uint64(req.FromBlock) {
+		return &pb.ListBlocksResponse{}, status.Error(codes.InvalidArgument, "to_block is invalid")
+	}
+
+	blocks, err := s.svc.ListBlocks(ctx, uint64(req.FromBlock), toBlock, req.Finality)
+	if err != nil {
+		return &pb.ListBlocksResponse{}, err
+	}
+
+	res := make([]*pb.Block, len(blocks))
+	for i, b := range blocks {
+		res[i] = newPbBlockHeader(b)
+	}
+	return &pb.ListBlocksResponse{Blocks: res}, nil
+}
+
+// newPbBlockHeader converts the block without its transactions, as listed.
+func newPbBlockHeader(b *model.Block) *pb.Block {
+	res := &pb.Block{
+		BlockNum:         int64(b.BlockNum),
+		BlockHash:        b.BlockHash,
+		BlockTime:        int64(b.BlockTime),
+		ParentHash:       b.ParentHash,
+		Finality:         b.Finality,
+		Miner:            b.Miner,
+		Difficulty:       b.Difficulty,
+		GasLimit:         int64(b.GasLimit),
+		GasUsed:          int64(b.GasUsed),
+		BaseFee:          b.BaseFee,
+		ExtraData:        b.ExtraData,
+		MixHash:          b.MixHash,
+		Nonce:            b.Nonce,
+		UnclesHash:       b.UnclesHash,
+		StateRoot:        b.StateRoot,
+		TransactionsRoot: b.TransactionsRoot,
+		ReceiptsRoot:     b.ReceiptsRoot,
+		LogsBloom:        b.LogsBloom,
+		WithdrawalsRoot:  b.WithdrawalsRoot,
+		ParentBeaconRoot: b.ParentBeaconRoot,
+		Size:             int64(b.Size),
+		Uncles:           b.Uncles,
+	}
+	if b.BlobGasUsed != nil {
+		res.BlobGasUsed = wrapperspb.UInt64(*b.BlobGasUsed)
+	}
+	if b.ExcessBlobGas != nil {
+		res.ExcessBlobGas = wrapperspb.UInt64(*b.ExcessBlobGas)
+	}
+	res.Withdrawals = make([]*pb.Withdrawal, len(b.Withdrawals))
+	for i, w := range b.Withdrawals {
+		res.Withdrawals[i] = &pb.Withdrawal{
+			Index:     int64(w.Index),
+			Validator: int64(w.Validator),
+			Address:   w.Address,
+			Amount:    int64(w.Amount),
+		}
+	}
+	return res
+}
+
+func newPbBlock(b *model.Block) *pb.Block {
+	res := newPbBlockHeader(b)
+	res.Transactions = b.TxHash
+	res.Orphaned = b.Orphaned
+	return res
 }
 
 func (s *EthereumServer) GetBlock(ctx context.Context, req *pb.GetBlockRequest) (*pb.GetBlockResponse, error) {
+	if req.Finality != "" && !model.IsFinality(req.Finality) {
+		return &pb.GetBlockResponse{}, status.Error(codes.InvalidArgument, "finality is invalid")
+	}
 	b, err := s.svc.GetBlock(ctx, uint64(req.BlockNum))
+	if err == nil && !model.ReachesFinality(b.Finality, req.Finality) {
+		err = service.ErrNotFound
+	}
 	if err == service.ErrNotFound {
 		return &pb.GetBlockResponse{}, status.Error(codes.NotFound, "block not found")
 	}
@@ -51,18 +199,114 @@ func (s *EthereumServer) GetBlock(ctx context.Context, req *pb.GetBlockRequest)
 		return &pb.GetBlockResponse{}, err
 	}
 
-	res := &pb.Block{
-		BlockNum:     int64(b.BlockNum),
-		BlockHash:    b.BlockHash,
-		BlockTime:    int64(b.BlockTime),
-		ParentHash:   b.ParentHash,
-		Transactions: b.TxHash,
+	res := newPbBlock(b)
+	if req.FullTransactions {
+		if res.FullTransactions, err = s.fullTransactions(ctx, b, req.Receipts); err != nil {
+			return &pb.GetBlockResponse{}, err
+		}
 	}
 	return &pb.GetBlockResponse{Block: res}, nil
 }
 
+// fullTransactions returns the transactions of the block, with their receipts
+// if asked.
+func (s *EthereumServer) fullTransactions(ctx context.Context, b *model.Block, receipts bool) ([]*pb.Transaction, error) {
+	txs, err := s.svc.GetBlockTransactions(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*pb.Transaction, len(txs))
+	for i, tx := range txs {
+		res[i] = newPbTransaction(tx)
+		if receipts && tx.Receipt != nil {
+			res[i].Receipt = newPbReceipt(tx.Receipt)
+		}
+	}
+	return res, nil
+}
+
+func (s *EthereumServer) GetBlockByHash(ctx context.Context, req *pb.GetBlockByHashRequest) (*pb.GetBlockByHashResponse, error) {
+	if req.Finality != "" && !model.IsFinality(req.Finality) {
+		return &pb.GetBlockByHashResponse{}, status.Error(codes.InvalidArgument, "finality is invalid")
+	}
+	b, err := s.svc.GetBlockByHash(ctx, req.BlockHash, req.Orphaned)
+	// an orphaned block never settles, it is returned whatever the finality
+	if err == nil && !b.Orphaned && !model.ReachesFinality(b.Finality, req.Finality) {
+		err = service.ErrNotFound
+	}
+	if err == service.ErrNotFound {
+		return &pb.GetBlockByHashResponse{}, status.Error(codes.NotFound, "block not found")
+	}
+	if err != nil {
+		return &pb.GetBlockByHashResponse{}, err
+	}
+	res := newPbBlock(b)
+	if req.FullTransactions {
+		if res.FullTransactions, err = s.fullTransactions(ctx, b, req.Receipts); err != nil {
+			return &pb.GetBlockByHashResponse{}, err
+		}
+	}
+	return &pb.GetBlockByHashResponse{Block: res}, nil
+}
+
+func (s *EthereumServer) GetBlockByTime(ctx context.Context, req *pb.GetBlockByTimeRequest) (*pb.GetBlockByTimeResponse, error) {
+	if req.Timestamp < 0 {
+		return &pb.GetBlockByTimeResponse{}, status.Error(codes.InvalidArgument, "timestamp is invalid")
+	}
+	var before bool
+	switch req.Closest {
+	case "", "before":
+		before = true
+	case "after":
+	default:
+		return &pb.GetBlockByTimeResponse{}, status.Error(codes.InvalidArgument, "closest is invalid")
+	}
+
+	b, err := s.svc.GetBlockByTime(ctx, uint64(req.Timestamp), before)
+	if err == service.ErrNotFound {
+		return &pb.GetBlockByTimeResponse{}, status.Error(codes.NotFound, "block not found")
+	}
+	if err != nil {
+		return &pb.GetBlockByTimeResponse{}, err
+	}
+	return &pb.GetBlockByTimeResponse{Block: newPbBlockHeader(b)}, nil
+}
+
+func (s *EthereumServer) ListBlocksByNumber(ctx context.Context, req *pb.ListBlocksByNumberRequest) (*pb.ListBlocksByNumberResponse, error) {
+	if len(req.BlockNums) > maxListSize {
+		return &pb.ListBlocksByNumberResponse{}, status.Error(codes.InvalidArgument, "block_nums is invalid")
+	}
+	nums := make([]uint64, len(req.BlockNums))
+	for i, num := range req.BlockNums {
+		if num < 0 {
+			return &pb.ListBlocksByNumberResponse{}, status.Error(codes.InvalidArgument, "block_nums is invalid")
+		}
+		nums[i] = uint64(num)
+	}
+	if len(nums) == 0 {
+		return &pb.ListBlocksByNumberResponse{}, nil
+	}
+
+	blocks, err := s.svc.ListBlocksByNumber(ctx, nums)
+	if err != nil {
+		return &pb.ListBlocksByNumberResponse{}, err
+	}
+
+	res := make([]*pb.Block, len(blocks))
+	for i, b := range blocks {
+		res[i] = newPbBlockHeader(b)
+	}
+	return &pb.ListBlocksByNumberResponse{Blocks: res}, nil
+}
+
 func (s *EthereumServer) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error) {
+	if req.Finality != "" && !model.IsFinality(req.Finality) {
+		return &pb.GetTransactionResponse{}, status.Error(codes.InvalidArgument, "finality is invalid")
+	}
 	tx, err := s.svc.GetTransaction(ctx, req.TxHash)
+	if err == nil && !model.ReachesFinality(tx.Finality, req.Finality) {
+		err = service.ErrNotFound
+	}
 	if err == service.ErrNotFound {
 		return &pb.GetTransactionResponse{}, status.Error(codes.NotFound, "transaction not found")
 	}
@@ -70,20 +314,533 @@ func (s *EthereumServer) GetTransaction(ctx context.Context, req *pb.GetTransact
 		return &pb.GetTransactionResponse{}, err
 	}
 
+	res := newPbTransaction(tx)
+	if req.Receipt {
+		receipt, err := s.svc.GetReceipt(ctx, req.TxHash)
+		if err != nil {
+			return &pb.GetTransactionResponse{}, err
+		}
+		res.Receipt = newPbReceipt(receipt)
+	}
+	return &pb.GetTransactionResponse{Tx: res}, nil
+}
+
+func (s *EthereumServer) ListTransactions(ctx context.Context, req *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
+	if len(req.TxHashes) > maxListSize {
+		return &pb.ListTransactionsResponse{}, status.Error(codes.InvalidArgument, "tx_hashes is invalid")
+	}
+	if len(req.TxHashes) == 0 {
+		return &pb.ListTransactionsResponse{}, nil
+	}
+
+	txs, err := s.svc.ListTransactions(ctx, req.TxHashes)
+	if err != nil {
+		return &pb.ListTransactionsResponse{}, err
+	}
+
+	res := make([]*pb.Transaction, len(txs))
+	for i, tx := range txs {
+		res[i] = newPbTransaction(tx)
+		if tx.Receipt != nil {
+			res[i].Receipt = newPbReceipt(tx.Receipt)
+		}
+	}
+	return &pb.ListTransactionsResponse{Txs: res}, nil
+}
+
+func (s *EthereumServer) GetAddressTransactions(ctx context.Context, req *pb.GetAddressTransactionsRequest) (*pb.GetAddressTransactionsResponse, error) {
+	switch req.Direction {
+	case "":
+		req.Direction = model.DirectionBoth
+	case model.DirectionIn, model.DirectionOut, model.DirectionBoth:
+	default:
+		return &pb.GetAddressTransactionsResponse{}, status.Error(codes.InvalidArgument, "direction is invalid")
+	}
+	if !validLimit(req.Limit, maxResultsLimit) {
+		return &pb.GetAddressTransactionsResponse{}, status.Error(codes.InvalidArgument, "limit is invalid")
+	}
+
+	cursor, err := model.DecodeCursor(req.PageToken)
+	if err != nil {
+		return &pb.GetAddressTransactionsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	switch req.Sort {
+	case "", model.SortAsc, model.SortDesc:
+	default:
+		return &pb.GetAddressTransactionsResponse{}, status.Error(codes.InvalidArgument, "sort is invalid")
+	}
+	if req.Sort != "" && cursor != nil {
+		return &pb.GetAddressTransactionsResponse{}, status.Error(codes.InvalidArgument, "page_token cannot be used with sort")
+	}
+	filter := &model.AddressTxFilter{
+		Address:   req.Address,
+		Cursor:    cursor,
+		Direction: req.Direction,
+		FromBlock: blockNumber(req.FromBlock),
+		ToBlock:   blockNumber(req.ToBlock),
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+		Sort:      req.Sort,
+		Receipts:  req.Receipts,
+	}
+
+	txs, err := s.svc.GetAddressTransactions(ctx, filter)
+	if err != nil {
+		return &pb.GetAddressTransactionsResponse{}, err
+	}
+
+	res := make([]*pb.Transaction, len(txs))
+	keys := make([]model.Cursor, len(txs))
+	for i, tx := range txs {
+		res[i] = newPbTransaction(tx)
+		if req.Receipts && tx.Receipt != nil {
+			res[i].Receipt = newPbReceipt(tx.Receipt)
+		}
+		keys[i] = model.Cursor{BlockNum: tx.BlockNum, TxHash: tx.TxHash}
+	}
+	if req.Sort != "" {
+		return &pb.GetAddressTransactionsResponse{Txs: res}, nil
+	}
+	next, prev := pageTokens(cursor, int(req.Limit), keys)
+	return &pb.GetAddressTransactionsResponse{Txs: res, NextPageToken: next, PrevPageToken: prev}, nil
+}
+
+func newPbTransaction(tx *model.Transaction) *pb.Transaction {
 	res := &pb.Transaction{
+		TxHash:               tx.TxHash,
+		BlockNum:             int64(tx.BlockNum),
+		Type:                 int32(tx.Type),
+		FromAddr:             tx.FromAddr,
+		ToAddr:               tx.ToAddr,
+		Nonce:                int64(tx.Nonce),
+		Gas:                  int64(tx.Gas),
+		GasPrice:             tx.GasPrice,
+		MaxFeePerGas:         tx.MaxFeePerGas,
+		MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+		MaxFeePerBlobGas:     tx.MaxFeePerBlobGas,
+		Data:                 tx.Data,
+		Value:                tx.Value,
+		BlobHashes:           tx.BlobHashes,
+		ChainId:              tx.ChainID,
+		V:                    tx.V,
+		R:                    tx.R,
+		S:                    tx.S,
+		Finality:             tx.Finality,
+		Decoded:              newPbDecoded(tx.Decoded),
+		Candidates:           newPbCandidates(tx.Candidates),
+	}
+	res.AccessList = make([]*pb.AccessTuple, len(tx.AccessList))
+	for i, tuple := range tx.AccessList {
+		res.AccessList[i] = &pb.AccessTuple{
+			Address:     tuple.Address,
+			StorageKeys: tuple.StorageKeys,
+		}
+	}
+	res.Logs = make([]*pb.Log, len(tx.Logs))
+	for i := range tx.Logs {
+		res.Logs[i] = newPbLog(&tx.Logs[i])
+	}
+	return res
+}
+
+func (s *EthereumServer) GetReceipt(ctx context.Context, req *pb.GetReceiptRequest) (*pb.GetReceiptResponse, error) {
+	receipt, err := s.svc.GetReceipt(ctx, req.TxHash)
+	if err == service.ErrNotFound {
+		return &pb.GetReceiptResponse{}, status.Error(codes.NotFound, "receipt not found")
+	}
+	if err != nil {
+		return &pb.GetReceiptResponse{}, err
+	}
+
+	return &pb.GetReceiptResponse{Receipt: newPbReceipt(receipt)}, nil
+}
+
+func newPbReceipt(receipt *model.Receipt) *pb.Receipt {
+	res := &pb.Receipt{
+		TxHash:            receipt.TxHash,
+		BlockNum:          int64(receipt.BlockNum),
+		BlockHash:         receipt.BlockHash,
+		TxIndex:           int32(receipt.TxIndex),
+		Type:              int32(receipt.Type),
+		Status:            int64(receipt.Status),
+		CumulativeGasUsed: int64(receipt.CumulativeGasUsed),
+		GasUsed:           int64(receipt.GasUsed),
+		EffectiveGasPrice: receipt.EffectiveGasPrice,
+		ContractAddress:   receipt.ContractAddress,
+	}
+	res.Logs = make([]*pb.Log, len(receipt.Logs))
+	for i := range receipt.Logs {
+		res.Logs[i] = newPbLog(&receipt.Logs[i])
+	}
+	return res
+}
+
+func (s *EthereumServer) GetLogs(ctx context.Context, req *pb.GetLogsRequest) (*pb.GetLogsResponse, error) {
+	if !validLimit(req.Limit, maxLogsLimit) {
+		return &pb.GetLogsResponse{}, status.Error(codes.InvalidArgument, "limit is invalid")
+	}
+	filter := &model.LogFilter{
+		Addresses: req.Addresses,
+		Topics:    make([][]string, len(req.Topics)),
+		FromBlock: blockNumber(req.FromBlock),
+		ToBlock:   blockNumber(req.ToBlock),
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+	}
+	for i, topics := range req.Topics {
+		filter.Topics[i] = topics.Topics
+	}
+
+	logs, err := s.svc.GetLogs(ctx, filter)
+	if err != nil {
+		return &pb.GetLogsResponse{}, err
+	}
+
+	res := make([]*pb.Log, len(logs))
+	for i, log := range logs {
+		res[i] = newPbLog(log)
+	}
+	return &pb.GetLogsResponse{Logs: res}, nil
+}
+
+func (s *EthereumServer) GetInternalTransactions(ctx context.Context, req *pb.GetInternalTransactionsRequest) (*pb.GetInternalTransactionsResponse, error) {
+	txs, err := s.svc.GetInternalTransactions(ctx, req.TxHash)
+	if err == service.ErrNotFound {
+		return &pb.GetInternalTransactionsResponse{}, status.Error(codes.NotFound, "transaction not found")
+	}
+	if err != nil {
+		return &pb.GetInternalTransactionsResponse{}, err
+	}
+
+	res := make([]*pb.InternalTransaction, len(txs))
+	for i, tx := range txs {
+		res[i] = newPbInternalTransaction(tx)
+	}
+	return &pb.GetInternalTransactionsResponse{InternalTransactions: res}, nil
+}
+
+func (s *EthereumServer) GetAddressInternalTransactions(ctx context.Context, req *pb.GetAddressInternalTransactionsRequest) (*pb.GetAddressInternalTransactionsResponse, error) {
+	if !validLimit(req.Limit, maxResultsLimit) {
+		return &pb.GetAddressInternalTransactionsResponse{}, status.Error(codes.InvalidArgument, "limit is invalid")
+	}
+	filter := &model.InternalTxFilter{
+		Address:    req.Address,
+		FromBlock:  blockNumber(req.FromBlock),
+		ToBlock:    blockNumber(req.ToBlock),
+		Limit:      int(req.Limit),
+		Offset:     int(req.Offset),
+		Descending: req.Descending,
+	}
+
+	txs, err := s.svc.GetAddressInternalTransactions(ctx, filter)
+	if err != nil {
+		return &pb.GetAddressInternalTransactionsResponse{}, err
+	}
+
+	res := make([]*pb.InternalTransaction, len(txs))
+	for i, tx := range txs {
+		res[i] = newPbInternalTransaction(tx)
+	}
+	return &pb.GetAddressInternalTransactionsResponse{InternalTransactions: res}, nil
+}
+
+func newPbInternalTransaction(tx *model.InternalTransaction) *pb.InternalTransaction {
+	return &pb.InternalTransaction{
 		TxHash:   tx.TxHash,
+		Index:    int32(tx.Index),
+		BlockNum: int64(tx.BlockNum),
+		Type:     tx.Type,
 		FromAddr: tx.FromAddr,
 		ToAddr:   tx.ToAddr,
-		Nonce:    int64(tx.Nonce),
-		Data:     tx.Data,
 		Value:    tx.Value,
+		Gas:      int64(tx.Gas),
+		GasUsed:  int64(tx.GasUsed),
+		Depth:    int32(tx.Depth),
+		Error:    tx.Error,
 	}
-	res.Logs = make([]*pb.Log, len(tx.Logs))
-	for i, log := range tx.Logs {
-		res.Logs[i] = &pb.Log{
-			Index: int32(log.Index),
-			Data:  log.Data,
+}
+
+func (s *EthereumServer) GetTokenTransfers(ctx context.Context, req *pb.GetTokenTransfersRequest) (*pb.GetTokenTransfersResponse, error) {
+	if !validLimit(req.Limit, maxResultsLimit) {
+		return &pb.GetTokenTransfersResponse{}, status.Error(codes.InvalidArgument, "limit is invalid")
+	}
+	filter := &model.TokenTransferFilter{
+		Token:      req.Token,
+		Address:    req.Address,
+		FromBlock:  blockNumber(req.FromBlock),
+		ToBlock:    blockNumber(req.ToBlock),
+		Limit:      int(req.Limit),
+		Offset:     int(req.Offset),
+		Descending: req.Descending,
+	}
+
+	transfers, err := s.svc.GetTokenTransfers(ctx, filter)
+	if err != nil {
+		return &pb.GetTokenTransfersResponse{}, err
+	}
+
+	res := make([]*pb.TokenTransfer, len(transfers))
+	for i, transfer := range transfers {
+		res[i] = &pb.TokenTransfer{
+			TxHash:   transfer.TxHash,
+			LogIndex: int32(transfer.LogIndex),
+			BlockNum: int64(transfer.BlockNum),
+			Token:    transfer.Token,
+			FromAddr: transfer.FromAddr,
+			ToAddr:   transfer.ToAddr,
+			Amount:   transfer.Amount,
+		}
+	}
+	return &pb.GetTokenTransfersResponse{Transfers: res}, nil
+}
+
+func (s *EthereumServer) GetNFTOwners(ctx context.Context, req *pb.GetNFTOwnersRequest) (*pb.GetNFTOwnersResponse, error) {
+	holdings, err := s.svc.GetNFTOwners(ctx, req.Token, req.TokenId)
+	if err != nil {
+		return &pb.GetNFTOwnersResponse{}, err
+	}
+	return &pb.GetNFTOwnersResponse{Holdings: newPbHoldings(holdings)}, nil
+}
+
+func (s *EthereumServer) GetNFTInventory(ctx context.Context, req *pb.GetNFTInventoryRequest) (*pb.GetNFTInventoryResponse, error) {
+	if !validLimit(req.Limit, maxResultsLimit) {
+		return &pb.GetNFTInventoryResponse{}, status.Error(codes.InvalidArgument, "limit is invalid")
+	}
+	holdings, err := s.svc.GetNFTInventory(ctx, req.Owner, int(req.Limit))
+	if err != nil {
+		return &pb.GetNFTInventoryResponse{}, err
+	}
+	return &pb.GetNFTInventoryResponse{Holdings: newPbHoldings(holdings)}, nil
+}
+
+func (s *EthereumServer) SetContractABI(ctx context.Context, req *pb.SetContractABIRequest) (*pb.SetContractABIResponse, error) {
+	err := s.svc.SetContractABI(ctx, req.Address, req.Abi)
+	if err == service.ErrInvalidABI {
+		return &pb.SetContractABIResponse{}, status.Error(codes.InvalidArgument, "abi is invalid")
+	}
+	if err != nil {
+		return &pb.SetContractABIResponse{}, err
+	}
+	return &pb.SetContractABIResponse{}, nil
+}
+
+func (s *EthereumServer) ImportSignatures(ctx context.Context, req *pb.ImportSignaturesRequest) (*pb.ImportSignaturesResponse, error) {
+	count, err := s.svc.ImportSignatures(ctx, strings.NewReader(req.Signatures))
+	if err != nil {
+		return &pb.ImportSignaturesResponse{}, err
+	}
+	return &pb.ImportSignaturesResponse{Count: int32(count)}, nil
+}
+
+func newPbHoldings(holdings []*model.NFTHolding) []*pb.NFTHolding {
+	res := make([]*pb.NFTHolding, len(holdings))
+	for i, holding := range holdings {
+		res[i] = &pb.NFTHolding{
+			Token:   holding.Token,
+			TokenId: holding.TokenID,
+			Owner:   holding.Owner,
+			Amount:  holding.Amount,
+		}
+	}
+	return res
+}
+
+func newPbLog(log *model.Log) *pb.Log {
+	return &pb.Log{
+		Index:      int32(log.Index),
+		Data:       log.Data,
+		Address:    log.Address,
+		Topics:     log.Topics,
+		BlockNum:   int64(log.BlockNum),
+		BlockHash:  log.BlockHash,
+		TxHash:     log.TxHash,
+		TxIndex:    int32(log.TxIndex),
+		Removed:    log.Removed,
+		Decoded:    newPbDecoded(log.Decoded),
+		Candidates: newPbCandidates(log.Candidates),
+	}
+}
+
+func newPbCandidates(candidates []*model.Decoded) []*pb.Decoded {
+	res := make([]*pb.Decoded, len(candidates))
+	for i, candidate := range candidates {
+		res[i] = newPbDecoded(candidate)
+	}
+	return res
+}
+
+func newPbDecoded(decoded *model.Decoded) *pb.Decoded {
+	if decoded == nil {
+		return nil
+	}
+	params := make([]*pb.DecodedParam, len(decoded.Params))
+	for i, param := range decoded.Params {
+		params[i] = &pb.DecodedParam{
+			Name:  param.Name,
+			Type:  param.Type,
+			Value: param.Value,
+		}
+	}
+	return &pb.Decoded{
+		Name:      decoded.Name,
+		Signature: decoded.Signature,
+		Params:    params,
+	}
+}
+
+const (
+	// blockEventQueueSize is the number of block events queued for a
+	// subscriber before it is dropped for falling behind.
+	blockEventQueueSize = 256
+
+	// maxFilterAddresses caps the addresses of a subscription filter.
+	maxFilterAddresses = 100
+)
+
+func (s *EthereumServer) SubscribeBlocks(req *pb.SubscribeBlocksRequest, stream pb.EthereumService_SubscribeBlocksServer) error {
+	return s.relayBlocks(stream.Context(), func(ev *model.BlockEvent) error {
+		return stream.Send(&pb.BlockEvent{Block: newPbBlock(ev.Block), Removed: ev.Removed})
+	})
+}
+
+func (s *EthereumServer) SubscribeLogs(req *pb.SubscribeLogsRequest, stream pb.EthereumService_SubscribeLogsServer) error {
+	if len(req.Addresses) > maxFilterAddresses {
+		return status.Error(codes.InvalidArgument, "addresses is invalid")
+	}
+	if len(req.Topics) > 4 {
+		return status.Error(codes.InvalidArgument, "topics is invalid")
+	}
+	filter := &model.LogFilter{
+		Addresses: req.Addresses,
+		Topics:    make([][]string, len(req.Topics)),
+	}
+	for i, topics := range req.Topics {
+		filter.Topics[i] = topics.Topics
+	}
+
+	return s.relayBlocks(stream.Context(), func(ev *model.BlockEvent) error {
+		for _, tx := range ev.Block.Transactions {
+			for i := range tx.Logs {
+				if !filter.Match(&tx.Logs[i]) {
+					continue
+				}
+				log := newPbLog(&tx.Logs[i])
+				log.Removed = ev.Removed
+				if err := stream.Send(log); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (s *EthereumServer) SubscribeTransactions(req *pb.SubscribeTransactionsRequest, stream pb.EthereumService_SubscribeTransactionsServer) error {
+	if len(req.Addresses) > maxFilterAddresses {
+		return status.Error(codes.InvalidArgument, "addresses is invalid")
+	}
+	switch req.Direction {
+	case "":
+		req.Direction = model.DirectionBoth
+	case model.DirectionIn, model.DirectionOut, model.DirectionBoth:
+	default:
+		return status.Error(codes.InvalidArgument, "direction is invalid")
+	}
+	filter := &model.TxFilter{Direction: req.Direction}
+	for _, address := range req.Addresses {
+		filter.Addresses = append(filter.Addresses, common.HexToAddress(address).String())
+	}
+
+	if req.Pending {
+		return s.relayPendingTransactions(stream, filter)
+	}
+	return s.relayBlocks(stream.Context(), func(ev *model.BlockEvent) error {
+		for _, tx := range ev.Block.Transactions {
+			if !filter.Match(tx) {
+				continue
+			}
+			res := newPbTransaction(tx)
+			if tx.Receipt != nil {
+				res.Receipt = newPbReceipt(tx.Receipt)
+			}
+			if err := stream.Send(&pb.TransactionEvent{Tx: res, Removed: ev.Removed}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// relayPendingTransactions sends the transactions entering the pool of an
+// upstream endpoint that match the filter. The index does not hold them.
+func (s *EthereumServer) relayPendingTransactions(stream pb.EthereumService_SubscribeTransactionsServer, filter *model.TxFilter) error {
+	txs := make(chan *model.Transaction)
+	sub, err := s.svc.SubscribePendingTransactions(stream.Context(), txs)
+	if err == service.ErrNoSubscribe {
+		return status.Error(codes.FailedPrecondition, "no endpoint supports subscriptions")
+	}
+	if err != nil {
+		return status.Error(codes.Unavailable, "pending transactions subscription failed")
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case tx := <-txs:
+			if !filter.Match(tx) {
+				continue
+			}
+			if err := stream.Send(&pb.TransactionEvent{Tx: newPbTransaction(tx)}); err != nil {
+				return err
+			}
+		case err := <-sub.Err():
+			if err == rpc.ErrSubscriptionQueueOverflow {
+				return status.Error(codes.ResourceExhausted, "subscriber fell behind")
+			}
+			return status.Error(codes.Unavailable, "pending transactions subscription dropped")
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// relayBlocks passes the block events to send until ctx is done or send fails.
+func (s *EthereumServer) relayBlocks(ctx context.Context, send func(*model.BlockEvent) error) error {
+	events := make(chan *model.BlockEvent)
+	sub := s.svc.SubscribeBlocks(events)
+	defer sub.Unsubscribe()
+
+	// the indexer waits for every subscriber to take an event, so the events
+	// are queued here and a subscriber that falls behind is dropped
+	queue := make(chan *model.BlockEvent, blockEventQueueSize)
+	overflow := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case ev := <-events:
+				select {
+				case queue <- ev:
+				default:
+					sub.Unsubscribe()
+					close(overflow)
+					return
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case ev := <-queue:
+			if err := send(ev); err != nil {
+				return err
+			}
+		case <-overflow:
+			return status.Error(codes.ResourceExhausted, "subscriber fell behind")
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
-	return &pb.GetTransactionResponse{Tx: res}, nil
 }
@@ -0,0 +1,155 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"Kumazan/go-ethereum-server/pb"
+	"Kumazan/go-ethereum-server/pkg/model"
+)
+
+func TestPageTokens(t *testing.T) {
+	newest := model.Cursor{BlockNum: 9, TxHash: "0x09"}
+	oldest := model.Cursor{BlockNum: 7, TxHash: "0x07"}
+	keys := []model.Cursor{newest, {BlockNum: 8, TxHash: "0x08"}, oldest}
+	back := func(c model.Cursor) *model.Cursor { return &c }
+	forward := func(c model.Cursor) *model.Cursor { c.Forward = true; return &c }
+
+	tests := []struct {
+		name   string
+		cursor *model.Cursor
+		limit  int
+		keys   []model.Cursor
+		next   *model.Cursor
+		prev   *model.Cursor
+	}{
+		{
+			name:  "first page",
+			limit: 3,
+			keys:  keys,
+			next:  back(oldest),
+			prev:  forward(newest),
+		},
+		{
+			name:  "short first page",
+			limit: 4,
+			keys:  keys,
+			prev:  forward(newest),
+		},
+		{
+			name:  "empty first page",
+			limit: 3,
+		},
+		{
+			name:   "page back",
+			cursor: back(model.Cursor{BlockNum: 10, TxHash: "0x10"}),
+			limit:  3,
+			keys:   keys,
+			next:   back(oldest),
+			prev:   forward(newest),
+		},
+		{
+			name:   "last page back",
+			cursor: back(model.Cursor{BlockNum: 10, TxHash: "0x10"}),
+			limit:  4,
+			keys:   keys,
+			prev:   forward(newest),
+		},
+		{
+			name:   "empty page back",
+			cursor: back(model.Cursor{BlockNum: 0, TxHash: "0x00"}),
+			limit:  3,
+		},
+		{
+			name:   "page forward",
+			cursor: forward(model.Cursor{BlockNum: 6, TxHash: "0x06"}),
+			limit:  3,
+			keys:   keys,
+			next:   forward(newest),
+			prev:   back(oldest),
+		},
+		{
+			name:   "short page forward",
+			cursor: forward(model.Cursor{BlockNum: 6, TxHash: "0x06"}),
+			limit:  4,
+			keys:   keys,
+			next:   forward(newest),
+			prev:   back(oldest),
+		},
+		{
+			name:   "empty page forward",
+			cursor: forward(newest),
+			limit:  3,
+			next:   forward(newest),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, prev := pageTokens(tt.cursor, tt.limit, tt.keys)
+			checkToken(t, "next", next, tt.next)
+			checkToken(t, "prev", prev, tt.prev)
+		})
+	}
+}
+
+func checkToken(t *testing.T, name, token string, want *model.Cursor) {
+	t.Helper()
+	got, err := model.DecodeCursor(token)
+	if err != nil {
+		t.Fatalf("%s token %q: %v", name, token, err)
+	}
+	switch {
+	case got == nil && want == nil:
+	case got == nil || want == nil || *got != *want:
+		t.Errorf("%s = %+v, want %+v", name, got, want)
+	}
+}
+
+func TestListingLimits(t *testing.T) {
+	// the limit is checked before the service, which is left out, is asked
+	s := &EthereumServer{}
+	ctx := context.Background()
+
+	listings := []struct {
+		name string
+		max  int32
+		list func(limit int32) error
+	}{
+		{"ListLastestBlocks", maxBlocksLimit, func(limit int32) error {
+			_, err := s.ListLastestBlocks(ctx, &pb.ListLastestBlocksRequest{Limit: limit})
+			return err
+		}},
+		{"GetLogs", maxLogsLimit, func(limit int32) error {
+			_, err := s.GetLogs(ctx, &pb.GetLogsRequest{Limit: limit})
+			return err
+		}},
+		{"GetAddressTransactions", maxResultsLimit, func(limit int32) error {
+			_, err := s.GetAddressTransactions(ctx, &pb.GetAddressTransactionsRequest{Limit: limit})
+			return err
+		}},
+		{"GetAddressInternalTransactions", maxResultsLimit, func(limit int32) error {
+			_, err := s.GetAddressInternalTransactions(ctx, &pb.GetAddressInternalTransactionsRequest{Limit: limit})
+			return err
+		}},
+		{"GetTokenTransfers", maxResultsLimit, func(limit int32) error {
+			_, err := s.GetTokenTransfers(ctx, &pb.GetTokenTransfersRequest{Limit: limit})
+			return err
+		}},
+		{"GetNFTInventory", maxResultsLimit, func(limit int32) error {
+			_, err := s.GetNFTInventory(ctx, &pb.GetNFTInventoryRequest{Limit: limit})
+			return err
+		}},
+	}
+	for _, listing := range listings {
+		t.Run(listing.name, func(t *testing.T) {
+			for _, limit := range []int32{-1, 0, listing.max + 1} {
+				if err := listing.list(limit); status.Code(err) != codes.InvalidArgument {
+					t.Errorf("limit %d error = %v, want InvalidArgument", limit, err)
+				}
+			}
+		})
+	}
+}
@@ -1,38 +1,175 @@
 package model
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"gorm.io/gorm"
 )
 
 type Block struct {
-	BlockNum     uint64         `json:"block_num" gorm:"primaryKey"`
-	BlockHash    string         `json:"block_hash"`
-	BlockTime    uint64         `json:"block_time"`
-	ParentHash   string         `json:"parent_hash"`
-	Transactions []*Transaction `json:"-" gorm:"foreignKey:BlockNum;references:BlockNum"`
-	TxHash       []string       `json:"transactions,omitempty" gorm:"-"`
+	BlockNum         uint64         `json:"block_num" gorm:"primaryKey"`
+	BlockHash        string         `json:"block_hash"`
+	BlockTime        uint64         `json:"block_time"`
+	ParentHash       string         `json:"parent_hash"`
+	Miner            string         `json:"miner"`
+	Difficulty       string         `json:"difficulty"`
+	GasLimit         uint64         `json:"gas_limit"`
+	GasUsed          uint64         `json:"gas_used"`
+	BaseFee          string         `json:"base_fee,omitempty"`
+	ExtraData        string         `json:"extra_data"`
+	MixHash          string         `json:"mix_hash"`
+	Nonce            string         `json:"nonce"`
+	UnclesHash       string         `json:"uncles_hash"`
+	StateRoot        string         `json:"state_root"`
+	TransactionsRoot string         `json:"transactions_root"`
+	ReceiptsRoot     string         `json:"receipts_root"`
+	LogsBloom        string         `json:"logs_bloom"`
+	WithdrawalsRoot  string         `json:"withdrawals_root,omitempty"`
+	BlobGasUsed      *uint64        `json:"blob_gas_used,omitempty"`
+	ExcessBlobGas    *uint64        `json:"excess_blob_gas,omitempty"`
+	ParentBeaconRoot string         `json:"parent_beacon_root,omitempty"`
+	Size             uint64         `json:"size"`
+	Uncles           Hashes         `json:"uncles,omitempty"`
+	Withdrawals      Withdrawals    `json:"withdrawals,omitempty"`
+	Finality         string         `json:"finality"`
+	Orphaned         bool           `json:"orphaned,omitempty" gorm:"-"`
+	Transactions     []*Transaction `json:"-" gorm:"foreignKey:BlockNum;references:BlockNum"`
+	TxHash           []string       `json:"transactions,omitempty" gorm:"-"`
 }
 
-func NewBlock(b *types.Block) *Block {
+type Withdrawals []Withdrawal
+type Withdrawal struct {
+	Index     uint64 `json:"index"`
+	Validator uint64 `json:"validator"`
+	Address   string `json:"address"`
+	Amount    uint64 `json:"amount"`
+}
+
+func (Withdrawals) GormDataType() string {
+	return "json"
+}
+
+func (w Withdrawals) Value() (driver.Value, error) {
+	if w == nil {
+		return nil, nil
+	}
+	return json.Marshal(w)
+}
+
+func (w *Withdrawals) Scan(value interface{}) error {
+	return scanJSON(value, w)
+}
+
+// BlockEvent announces a block added to the chain, or removed from it by a
+// reorg.
+type BlockEvent struct {
+	Block   *Block
+	Removed bool
+}
+
+func NewBlock(b *types.Block, signer types.Signer) (*Block, error) {
 	header := b.Header()
 	blockNum := header.Number.Uint64()
 	txns := make([]*Transaction, len(b.Transactions()))
 	for i, tx := range b.Transactions() {
-		txns[i] = NewTransaction(tx)
+		txn, err := NewTransaction(tx, signer)
+		if err != nil {
+			return nil, err
+		}
+		txns[i] = txn
 		txns[i].BlockNum = blockNum
 	}
-	return &Block{
-		BlockNum:     blockNum,
-		BlockHash:    header.Hash().String(),
-		BlockTime:    header.Time,
-		ParentHash:   header.ParentHash.String(),
-		Transactions: txns,
+	block := &Block{
+		BlockNum:         blockNum,
+		BlockHash:        header.Hash().String(),
+		BlockTime:        header.Time,
+		ParentHash:       header.ParentHash.String(),
+		Miner:            header.Coinbase.String(),
+		Difficulty:       header.Difficulty.String(),
+		GasLimit:         header.GasLimit,
+		GasUsed:          header.GasUsed,
+		ExtraData:        hexutil.Encode(header.Extra),
+		MixHash:          header.MixDigest.String(),
+		Nonce:            hexutil.Encode(header.Nonce[:]),
+		UnclesHash:       header.UncleHash.String(),
+		StateRoot:        header.Root.String(),
+		TransactionsRoot: header.TxHash.String(),
+		ReceiptsRoot:     header.ReceiptHash.String(),
+		LogsBloom:        hexutil.Encode(header.Bloom[:]),
+		BlobGasUsed:      header.BlobGasUsed,
+		ExcessBlobGas:    header.ExcessBlobGas,
+		Size:             b.Size(),
+		Finality:         FinalityLatest,
+		Transactions:     txns,
+	}
+	if header.BaseFee != nil {
+		block.BaseFee = header.BaseFee.String()
+	}
+	if header.WithdrawalsHash != nil {
+		block.WithdrawalsRoot = header.WithdrawalsHash.String()
+		block.Withdrawals = make(Withdrawals, len(b.Withdrawals()))
+		for i, w := range b.Withdrawals() {
+			block.Withdrawals[i] = Withdrawal{
+				Index:     w.Index,
+				Validator: w.Validator,
+				Address:   w.Address.String(),
+				Amount:    w.Amount,
+			}
+		}
+	}
+	if header.ParentBeaconRoot != nil {
+		block.ParentBeaconRoot = header.ParentBeaconRoot.String()
 	}
+	if uncles := b.Uncles(); len(uncles) > 0 {
+		block.Uncles = make(Hashes, len(uncles))
+		for i, uncle := range uncles {
+			block.Uncles[i] = uncle.Hash().String()
+		}
+	}
+	return block, nil
+}
+
+// Finality tells how settled a block is, following the block tags of the node:
+// a latest block may still be reorged out, a safe block is unlikely to be and
+// a finalized block cannot be.
+const (
+	FinalityLatest    = "latest"
+	FinalitySafe      = "safe"
+	FinalityFinalized = "finalized"
+)
+
+var finalityRanks = map[string]int{
+	FinalityLatest:    0,
+	FinalitySafe:      1,
+	FinalityFinalized: 2,
+}
+
+// IsFinality reports whether finality is one of the known finality values.
+func IsFinality(finality string) bool {
+	_, ok := finalityRanks[finality]
+	return ok
+}
+
+// ReachesFinality reports whether a block at finality is at least as settled
+// as want. An empty want is the same as latest.
+func ReachesFinality(finality, want string) bool {
+	return finalityRanks[finality] >= finalityRanks[want]
 }
 
 func (b Block) MarshalBinary() (data []byte, err error) {
@@ -40,49 +177,926 @@ func (b Block) MarshalBinary() (data []byte, err error) {
 }
 
 type Transaction struct {
+	TxHash               string     `json:"tx_hash" gorm:"primaryKey"`
+	BlockNum             uint64     `json:"block_num"`
+	Type                 uint8      `json:"type"`
+	FromAddr             string     `json:"from"`
+	ToAddr               string     `json:"to"`
+	Nonce                uint64     `json:"nonce"`
+	Gas                  uint64     `json:"gas"`
+	GasPrice             string     `json:"gas_price"`
+	MaxFeePerGas         string     `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string     `json:"max_priority_fee_per_gas,omitempty"`
+	MaxFeePerBlobGas     string     `json:"max_fee_per_blob_gas,omitempty"`
+	Data                 string     `json:"data"`
+	Value                string     `json:"value"`
+	AccessList           AccessList `json:"access_list,omitempty"`
+	BlobHashes           Hashes     `json:"blob_hashes,omitempty"`
+	ChainID              string     `json:"chain_id,omitempty"`
+	V                    string     `json:"v"`
+	R                    string     `json:"r"`
+	S                    string     `json:"s"`
+	Finality             string     `json:"finality" gorm:"-"`
+	Decoded              *Decoded   `json:"decoded,omitempty" gorm:"-"`
+	Candidates           []*Decoded `json:"candidates,omitempty" gorm:"-"`
+	Logs                 []Log      `json:"logs" gorm:"-"`
+	Receipt              *Receipt   `json:"receipt,omitempty" gorm:"foreignKey:TxHash;references:TxHash"`
+
+	InternalTransactions []InternalTransaction `json:"-" gorm:"foreignKey:TxHash;references:TxHash"`
+}
+
+type AccessList []AccessTuple
+type AccessTuple struct {
+	Address     string   `json:"address"`
+	StorageKeys []string `json:"storage_keys"`
+}
+
+func (AccessList) GormDataType() string {
+	return "json"
+}
+
+func (a AccessList) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return json.Marshal(a)
+}
+
+func (a *AccessList) Scan(value interface{}) error {
+	return scanJSON(value, a)
+}
+
+type Hashes []string
+
+func (Hashes) GormDataType() string {
+	return "json"
+}
+
+func (h Hashes) Value() (driver.Value, error) {
+	if h == nil {
+		return nil, nil
+	}
+	return json.Marshal(h)
+}
+
+func (h *Hashes) Scan(value interface{}) error {
+	return scanJSON(value, h)
+}
+
+func scanJSON(value interface{}, dest interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, dest)
+	case string:
+		return json.Unmarshal([]byte(v), dest)
+	default:
+		return errors.New("type assertion to []byte failed")
+	}
+}
+
+type Receipt struct {
+	TxHash            string `json:"tx_hash" gorm:"primaryKey"`
+	BlockNum          uint64 `json:"block_num"`
+	BlockHash         string `json:"block_hash"`
+	TxIndex           uint   `json:"tx_index"`
+	Type              uint8  `json:"type"`
+	Status            uint64 `json:"status"`
+	CumulativeGasUsed uint64 `json:"cumulative_gas_used"`
+	GasUsed           uint64 `json:"gas_used"`
+	EffectiveGasPrice string `json:"effective_gas_price"`
+	ContractAddress   string `json:"contract_address,omitempty"`
+	Logs              []Log  `json:"logs" gorm:"foreignKey:TxHash;references:TxHash"`
+
+	TokenTransfers []TokenTransfer `json:"-" gorm:"foreignKey:TxHash;references:TxHash"`
+	NFTTransfers   []NFTTransfer   `json:"-" gorm:"foreignKey:TxHash;references:TxHash"`
+}
+
+func NewReceipt(r *types.Receipt) *Receipt {
+	var effectiveGasPrice string
+	if r.EffectiveGasPrice != nil {
+		effectiveGasPrice = r.EffectiveGasPrice.String()
+	}
+	var contractAddress string
+	if r.ContractAddress != (common.Address{}) {
+		contractAddress = r.ContractAddress.String()
+	}
+	logs := make([]Log, len(r.Logs))
+	var transfers []TokenTransfer
+	var nftTransfers []NFTTransfer
+	for i, log := range r.Logs {
+		logs[i] = NewLog(log)
+		if transfer, ok := NewTokenTransfer(&logs[i]); ok {
+			transfers = append(transfers, *transfer)
+		}
+		for _, transfer := range NewNFTTransfers(&logs[i]) {
+			nftTransfers = append(nftTransfers, *transfer)
+		}
+	}
+	return &Receipt{
+		TxHash:            r.TxHash.String(),
+		BlockNum:          r.BlockNumber.Uint64(),
+		BlockHash:         r.BlockHash.String(),
+		TxIndex:           r.TransactionIndex,
+		Type:              r.Type,
+		Status:            r.Status,
+		CumulativeGasUsed: r.CumulativeGasUsed,
+		GasUsed:           r.GasUsed,
+		EffectiveGasPrice: effectiveGasPrice,
+		ContractAddress:   contractAddress,
+		Logs:              logs,
+		TokenTransfers:    transfers,
+		NFTTransfers:      nftTransfers,
+	}
+}
+
+type Log struct {
+	Address    string     `json:"address"`
+	Topics     []string   `json:"topics" gorm:"-"`
+	Topic0     *string    `json:"-"`
+	Topic1     *string    `json:"-"`
+	Topic2     *string    `json:"-"`
+	Topic3     *string    `json:"-"`
+	Data       string     `json:"data"`
+	BlockNum   uint64     `json:"block_num"`
+	BlockHash  string     `json:"block_hash"`
+	TxHash     string     `json:"tx_hash" gorm:"primaryKey"`
+	TxIndex    uint       `json:"tx_index"`
+	Index      uint       `json:"index" gorm:"primaryKey;column:log_index"`
+	Removed    bool       `json:"removed"`
+	Decoded    *Decoded   `json:"decoded,omitempty" gorm:"-"`
+	Candidates []*Decoded `json:"candidates,omitempty" gorm:"-"`
+}
+
+func NewLog(l *types.Log) Log {
+	topics := make([]string, len(l.Topics))
+	for i, topic := range l.Topics {
+		topics[i] = topic.String()
+	}
+	return Log{
+		Address:   l.Address.String(),
+		Topics:    topics,
+		Data:      hexutil.Encode(l.Data),
+		BlockNum:  l.BlockNumber,
+		BlockHash: l.BlockHash.String(),
+		TxHash:    l.TxHash.String(),
+		TxIndex:   l.TxIndex,
+		Index:     l.Index,
+		Removed:   l.Removed,
+	}
+}
+
+// BeforeSave spreads the topics over the indexed topic columns.
+func (l *Log) BeforeSave(db *gorm.DB) error {
+	var topics [4]*string
+	for i := range l.Topics {
+		if i < len(topics) {
+			topics[i] = &l.Topics[i]
+		}
+	}
+	l.Topic0, l.Topic1, l.Topic2, l.Topic3 = topics[0], topics[1], topics[2], topics[3]
+	return nil
+}
+
+// AfterFind collects the topic columns back into Topics.
+func (l *Log) AfterFind(db *gorm.DB) error {
+	l.Topics = make([]string, 0, 4)
+	for _, topic := range []*string{l.Topic0, l.Topic1, l.Topic2, l.Topic3} {
+		if topic == nil {
+			break
+		}
+		l.Topics = append(l.Topics, *topic)
+	}
+	return nil
+}
+
+// TransferTopic is the topic of Transfer(address,address,uint256), the event
+// emitted by both ERC-20 and ERC-721 token transfers.
+var TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")).String()
+
+// TokenTransfer is an ERC-20 Transfer event decoded from a log.
+type TokenTransfer struct {
 	TxHash   string `json:"tx_hash" gorm:"primaryKey"`
-	BlockNum uint64 `json:"-"`
+	LogIndex uint   `json:"log_index" gorm:"primaryKey"`
+	BlockNum uint64 `json:"block_num"`
+	Token    string `json:"token"`
+	FromAddr string `json:"from"`
+	ToAddr   string `json:"to"`
+	Amount   string `json:"amount"`
+}
+
+// NewTokenTransfer decodes l if it is an ERC-20 Transfer event, which has the
+// sender and recipient as topics and the amount as data. ERC-721 transfers
+// share the topic but also index the token id, so they have four topics.
+func NewTokenTransfer(l *Log) (*TokenTransfer, bool) {
+	if len(l.Topics) != 3 || l.Topics[0] != TransferTopic {
+		return nil, false
+	}
+	data, err := hexutil.Decode(l.Data)
+	if err != nil || len(data) != common.HashLength {
+		return nil, false
+	}
+	return &TokenTransfer{
+		TxHash:   l.TxHash,
+		LogIndex: l.Index,
+		BlockNum: l.BlockNum,
+		Token:    l.Address,
+		FromAddr: common.HexToAddress(l.Topics[1]).String(),
+		ToAddr:   common.HexToAddress(l.Topics[2]).String(),
+		Amount:   new(big.Int).SetBytes(data).String(),
+	}, true
+}
+
+// TokenTransferFilter selects token transfers of Token, or involving Address
+// as sender or recipient. A nil FromBlock or ToBlock stands for the latest
+// block. Transfers are listed oldest first unless Descending.
+type TokenTransferFilter struct {
+	Token      string
+	Address    string
+	FromBlock  *uint64
+	ToBlock    *uint64
+	Limit      int
+	Offset     int
+	Descending bool
+}
+
+var (
+	// TransferSingleTopic and TransferBatchTopic are the topics of the
+	// ERC-1155 transfer events.
+	TransferSingleTopic = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)")).String()
+	TransferBatchTopic  = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])")).String()
+
+	uint256Arrays abi.Arguments
+)
+
+func init() {
+	uint256Array, err := abi.NewType("uint256[]", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	uint256Arrays = abi.Arguments{{Type: uint256Array}, {Type: uint256Array}}
+}
+
+const (
+	ERC721  = "erc721"
+	ERC1155 = "erc1155"
+)
+
+// NFTTransfer is the transfer of an ERC-721 or ERC-1155 token decoded from a
+// log. An ERC-1155 TransferBatch event makes one transfer per token id,
+// told apart by BatchIndex.
+type NFTTransfer struct {
+	TxHash     string `json:"tx_hash" gorm:"primaryKey"`
+	LogIndex   uint   `json:"log_index" gorm:"primaryKey"`
+	BatchIndex uint   `json:"batch_index" gorm:"primaryKey"`
+	BlockNum   uint64 `json:"block_num"`
+	Standard   string `json:"standard"`
+	Token      string `json:"token"`
+	Operator   string `json:"operator,omitempty"`
+	FromAddr   string `json:"from"`
+	ToAddr     string `json:"to"`
+	TokenID    string `json:"token_id"`
+	Amount     string `json:"amount"`
+}
+
+// NewNFTTransfers decodes l if it is an ERC-721 Transfer event, which indexes
+// the token id as the fourth topic, or an ERC-1155 TransferSingle or
+// TransferBatch event. It returns nil for any other log.
+func NewNFTTransfers(l *Log) []*NFTTransfer {
+	if len(l.Topics) != 4 {
+		return nil
+	}
+	transfer := NFTTransfer{
+		TxHash:   l.TxHash,
+		LogIndex: l.Index,
+		BlockNum: l.BlockNum,
+		Token:    l.Address,
+	}
+	data, err := hexutil.Decode(l.Data)
+	if err != nil {
+		return nil
+	}
+
+	switch l.Topics[0] {
+	case TransferTopic:
+		if len(data) != 0 {
+			return nil
+		}
+		transfer.Standard = ERC721
+		transfer.FromAddr = common.HexToAddress(l.Topics[1]).String()
+		transfer.ToAddr = common.HexToAddress(l.Topics[2]).String()
+		transfer.TokenID = common.HexToHash(l.Topics[3]).Big().String()
+		transfer.Amount = "1"
+		return []*NFTTransfer{&transfer}
+
+	case TransferSingleTopic:
+		if len(data) != 2*common.HashLength {
+			return nil
+		}
+		transfer.Standard = ERC1155
+		transfer.Operator = common.HexToAddress(l.Topics[1]).String()
+		transfer.FromAddr = common.HexToAddress(l.Topics[2]).String()
+		transfer.ToAddr = common.HexToAddress(l.Topics[3]).String()
+		transfer.TokenID = new(big.Int).SetBytes(data[:common.HashLength]).String()
+		transfer.Amount = new(big.Int).SetBytes(data[common.HashLength:]).String()
+		return []*NFTTransfer{&transfer}
+
+	case TransferBatchTopic:
+		values, err := uint256Arrays.Unpack(data)
+		if err != nil {
+			return nil
+		}
+		ids, amounts := values[0].([]*big.Int), values[1].([]*big.Int)
+		if len(ids) != len(amounts) {
+			return nil
+		}
+		transfer.Standard = ERC1155
+		transfer.Operator = common.HexToAddress(l.Topics[1]).String()
+		transfer.FromAddr = common.HexToAddress(l.Topics[2]).String()
+		transfer.ToAddr = common.HexToAddress(l.Topics[3]).String()
+		transfers := make([]*NFTTransfer, len(ids))
+		for i := range ids {
+			t := transfer
+			t.BatchIndex = uint(i)
+			t.TokenID = ids[i].String()
+			t.Amount = amounts[i].String()
+			transfers[i] = &t
+		}
+		return transfers
+	}
+	return nil
+}
+
+// NFTHolding is the amount of a token id held by an owner, summed over the
+// indexed NFT transfers. It is always 1 for an ERC-721 token.
+type NFTHolding struct {
+	Token   string `json:"token"`
+	TokenID string `json:"token_id"`
+	Owner   string `json:"owner"`
+	Amount  string `json:"amount"`
+}
+
+const (
+	DirectionIn   = "in"
+	DirectionOut  = "out"
+	DirectionBoth = "both"
+)
+
+// AddressTxFilter selects the transactions sent by Address (DirectionOut),
+// received by it (DirectionIn) or both. A nil FromBlock or ToBlock stands for
+// the latest block. Transactions are listed newest first by block and hash,
+// to be paged with a Cursor, unless Sort orders them by block and position in
+// the block, and with their receipts when Receipts.
+type AddressTxFilter struct {
+	Address   string
+	Direction string
+	FromBlock *uint64
+	ToBlock   *uint64
+	Cursor    *Cursor
+	Limit     int
+	Offset    int
+	Sort      string
+	Receipts  bool
+}
+
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// TxFilter selects the transactions sent by any of Addresses (DirectionOut),
+// received by one of them (DirectionIn) or both, as a subscription does. No
+// addresses match every transaction.
+type TxFilter struct {
+	Addresses []string
+	Direction string
+}
+
+// Match reports whether the transaction is sent or received by an address of
+// the filter.
+func (f *TxFilter) Match(tx *Transaction) bool {
+	if len(f.Addresses) == 0 {
+		return true
+	}
+	switch f.Direction {
+	case DirectionIn:
+		return contains(f.Addresses, tx.ToAddr)
+	case DirectionOut:
+		return contains(f.Addresses, tx.FromAddr)
+	default:
+		return contains(f.Addresses, tx.FromAddr) || contains(f.Addresses, tx.ToAddr)
+	}
+}
+
+// Cursor is a position in a listing ordered newest first, by block number and
+// then transaction hash. A page after a cursor holds the entries older than it,
+// or the newer ones when Forward is set, so paging stays stable while new
+// blocks arrive. It is handed to clients as an opaque token.
+type Cursor struct {
+	BlockNum uint64 `json:"n"`
+	TxHash   string `json:"h,omitempty"`
+	Forward  bool   `json:"f,omitempty"`
+}
+
+var ErrInvalidCursor = errors.New("cursor is invalid")
+
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a token made by Encode. An empty token is a nil cursor.
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c *Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c == nil {
+		return nil, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// InternalTransaction is a call made by a contract during a transaction, taken
+// from a flattened call trace. Index orders the calls depth first, and the top
+// level call of the transaction itself is not included.
+type InternalTransaction struct {
+	TxHash   string `json:"tx_hash" gorm:"primaryKey"`
+	Index    uint   `json:"index" gorm:"primaryKey;column:trace_index"`
+	BlockNum uint64 `json:"block_num"`
+	Type     string `json:"type"`
 	FromAddr string `json:"from"`
 	ToAddr   string `json:"to"`
-	Nonce    uint64 `json:"nonce"`
-	Data     string `json:"data"`
 	Value    string `json:"value"`
-	Logs     Logs   `json:"logs"`
+	Gas      uint64 `json:"gas"`
+	GasUsed  uint64 `json:"gas_used"`
+	Depth    uint   `json:"depth"`
+	Error    string `json:"error,omitempty"`
 }
 
-type Logs []Log
-type Log struct {
-	Index uint   `json:"index"`
-	Data  string `json:"data"`
+// InternalTxFilter selects the internal transactions sent or received by
+// Address. A nil FromBlock or ToBlock stands for the latest block. They are
+// listed oldest first unless Descending.
+type InternalTxFilter struct {
+	Address    string
+	FromBlock  *uint64
+	ToBlock    *uint64
+	Limit      int
+	Offset     int
+	Descending bool
 }
 
-func (l *Logs) Value() (driver.Value, error) {
-	return json.Marshal(l)
+// LogFilter selects logs the same way as eth_getLogs. Each position of Topics
+// matches any of the listed topics, and an empty position matches anything.
+// A nil FromBlock or ToBlock stands for the latest block.
+type LogFilter struct {
+	Addresses []string
+	Topics    [][]string
+	FromBlock *uint64
+	ToBlock   *uint64
+	Limit     int
+	Offset    int
 }
 
-func (l *Logs) Scan(value interface{}) error {
-	b, ok := value.([]byte)
-	if !ok {
-		return errors.New("type assertion to []byte failed")
+// Match reports whether the log matches the addresses and topics of the
+// filter, ignoring its block range.
+func (f *LogFilter) Match(l *Log) bool {
+	if len(f.Addresses) > 0 && !contains(f.Addresses, l.Address) {
+		return false
 	}
-	return json.Unmarshal(b, &l)
+	for i, topics := range f.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(l.Topics) || !contains(topics, l.Topics[i]) {
+			return false
+		}
+	}
+	return true
 }
 
-func NewTransaction(tx *types.Transaction) *Transaction {
-	msg, _ := tx.AsMessage(types.NewEIP155Signer(tx.ChainId()))
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func NewTransaction(tx *types.Transaction, signer types.Signer) (*Transaction, error) {
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return nil, err
+	}
 	var toAddr string
 	if to := tx.To(); to != nil {
 		toAddr = to.String()
 	}
-	data := common.BytesToHash(tx.Data())
-	return &Transaction{
+	txn := &Transaction{
 		TxHash:   tx.Hash().String(),
-		FromAddr: msg.From().String(),
+		Type:     tx.Type(),
+		FromAddr: from.String(),
 		ToAddr:   toAddr,
 		Nonce:    tx.Nonce(),
-		Data:     data.String(),
+		Gas:      tx.Gas(),
+		GasPrice: tx.GasPrice().String(),
+		Data:     hexutil.Encode(tx.Data()),
 		Value:    tx.Value().String(),
 	}
+	switch tx.Type() {
+	case types.DynamicFeeTxType, types.BlobTxType:
+		txn.MaxFeePerGas = tx.GasFeeCap().String()
+		txn.MaxPriorityFeePerGas = tx.GasTipCap().String()
+	}
+	if tx.Type() == types.BlobTxType {
+		txn.MaxFeePerBlobGas = tx.BlobGasFeeCap().String()
+	}
+	// a legacy transaction signed before EIP-155 is not bound to a chain
+	if tx.Protected() {
+		txn.ChainID = tx.ChainId().String()
+	}
+	v, r, s := tx.RawSignatureValues()
+	txn.V, txn.R, txn.S = v.String(), r.String(), s.String()
+	if accessList := tx.AccessList(); accessList != nil {
+		txn.AccessList = make(AccessList, len(accessList))
+		for i, tuple := range accessList {
+			storageKeys := make([]string, len(tuple.StorageKeys))
+			for j, key := range tuple.StorageKeys {
+				storageKeys[j] = key.String()
+			}
+			txn.AccessList[i] = AccessTuple{
+				Address:     tuple.Address.String(),
+				StorageKeys: storageKeys,
+			}
+		}
+	}
+	if blobHashes := tx.BlobHashes(); blobHashes != nil {
+		txn.BlobHashes = make(Hashes, len(blobHashes))
+		for i, hash := range blobHashes {
+			txn.BlobHashes[i] = hash.String()
+		}
+	}
+	return txn, nil
+}
+
+// ContractABI is the ABI of a contract, used to decode its calls and events.
+type ContractABI struct {
+	Address   string `json:"address" gorm:"primaryKey"`
+	ABI       string `json:"abi"`
+	UpdatedAt time.Time
+}
+
+// Decoded is a method call or event decoded with the ABI of a contract.
+type Decoded struct {
+	Name      string         `json:"name"`
+	Signature string         `json:"signature"`
+	Params    []DecodedParam `json:"params"`
+}
+
+// DecodedParam is an argument of a decoded call or event. Numbers are
+// formatted in decimal, addresses and bytes in hex, and arrays and tuples as
+// JSON.
+type DecodedParam struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// DecodeCall decodes the input data of a call to contract, or returns nil when
+// it does not match any method of the ABI.
+func DecodeCall(contract *abi.ABI, data string) *Decoded {
+	input, err := hexutil.Decode(data)
+	if err != nil || len(input) < 4 {
+		return nil
+	}
+	method, err := contract.MethodById(input[:4])
+	if err != nil {
+		return nil
+	}
+	// unpacked by position, as the inputs of a method need not be named
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil
+	}
+	return &Decoded{
+		Name:      method.Name,
+		Signature: method.Sig,
+		Params:    decodedParams(method.Inputs, values),
+	}
+}
+
+// DecodeEvent decodes l with the ABI of the contract that emitted it, or
+// returns nil when it does not match any event of the ABI.
+func DecodeEvent(contract *abi.ABI, l *Log) *Decoded {
+	if len(l.Topics) == 0 {
+		return nil
+	}
+	event, err := contract.EventByID(common.HexToHash(l.Topics[0]))
+	if err != nil || event.Anonymous {
+		return nil
+	}
+	data, err := hexutil.Decode(l.Data)
+	if err != nil {
+		return nil
+	}
+
+	unindexed, err := event.Inputs.NonIndexed().Unpack(data)
+	if err != nil {
+		return nil
+	}
+	values, ok := eventValues(event.Inputs, unindexed, l.Topics[1:])
+	if !ok {
+		return nil
+	}
+	return &Decoded{
+		Name:      event.Name,
+		Signature: event.Sig,
+		Params:    decodedParams(event.Inputs, values),
+	}
+}
+
+// Signature is a text signature of a function or event, such as
+// transfer(address,uint256), with the selector of a function and the topic of
+// an event by that signature.
+type Signature struct {
+	Signature string `gorm:"primaryKey"`
+	Selector  string
+	Topic     string
+}
+
+var signaturePattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*\(.*\)$`)
+
+// NewSignature hashes the text signature sig, or returns false when it is not
+// one.
+func NewSignature(sig string) (*Signature, bool) {
+	if !signaturePattern.MatchString(sig) {
+		return nil, false
+	}
+	if _, _, err := parseSignature(sig); err != nil {
+		return nil, false
+	}
+	hash := crypto.Keccak256([]byte(sig))
+	return &Signature{
+		Signature: sig,
+		Selector:  hexutil.Encode(hash[:4]),
+		Topic:     hexutil.Encode(hash),
+	}, true
+}
+
+// DecodeCallBySignature decodes the input data of a call as a call of the
+// function sig, or returns nil when the data does not fit its arguments.
+func DecodeCallBySignature(sig string, data string) *Decoded {
+	input, err := hexutil.Decode(data)
+	if err != nil || len(input) < 4 {
+		return nil
+	}
+	name, args, err := parseSignature(sig)
+	if err != nil {
+		return nil
+	}
+	values, ok := unpackExact(args, input[4:])
+	if !ok {
+		return nil
+	}
+	return &Decoded{Name: name, Signature: sig, Params: decodedParams(args, values)}
+}
+
+// DecodeEventBySignature decodes l as the event sig, or returns nil when the
+// log does not fit its arguments. As a text signature does not tell which
+// arguments are indexed, the leading ones are taken to be, one per topic.
+func DecodeEventBySignature(sig string, l *Log) *Decoded {
+	name, args, err := parseSignature(sig)
+	if err != nil || len(l.Topics) == 0 || len(l.Topics)-1 > len(args) {
+		return nil
+	}
+	data, err := hexutil.Decode(l.Data)
+	if err != nil {
+		return nil
+	}
+
+	indexed := args[:len(l.Topics)-1]
+	for i := range indexed {
+		indexed[i].Indexed = true
+	}
+	unindexed, ok := unpackExact(args[len(indexed):], data)
+	if !ok {
+		return nil
+	}
+	values, ok := eventValues(args, unindexed, l.Topics[1:])
+	if !ok {
+		return nil
+	}
+	return &Decoded{Name: name, Signature: sig, Params: decodedParams(args, values)}
+}
+
+// eventValues merges the unpacked values of the non-indexed args with the
+// indexed ones parsed from topics, in the order of args. The topics are
+// parsed by position, so that unnamed args do not collapse onto one key.
+func eventValues(args abi.Arguments, unindexed []interface{}, topics []string) ([]interface{}, bool) {
+	var indexed abi.Arguments
+	for i, arg := range args {
+		if arg.Indexed {
+			arg.Name = strconv.Itoa(i)
+			indexed = append(indexed, arg)
+		}
+	}
+	if len(indexed) != len(topics) || len(indexed)+len(unindexed) != len(args) {
+		return nil, false
+	}
+	hashes := make([]common.Hash, len(topics))
+	for i, topic := range topics {
+		hashes[i] = common.HexToHash(topic)
+	}
+	parsed := make(map[string]interface{}, len(indexed))
+	if err := abi.ParseTopicsIntoMap(parsed, indexed, hashes); err != nil {
+		return nil, false
+	}
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		if arg.Indexed {
+			values[i] = parsed[strconv.Itoa(i)]
+		} else {
+			values[i], unindexed = unindexed[0], unindexed[1:]
+		}
+	}
+	return values, true
+}
+
+// unpackExact unpacks data into values by argument position, but only when the
+// values encode back to data. Signatures are guessed from a selector or
+// topic, and the check rejects the ones the data merely happens to fit, e.g.
+// with bytes left over.
+func unpackExact(args abi.Arguments, data []byte) ([]interface{}, bool) {
+	values, err := args.UnpackValues(data)
+	if err != nil {
+		return nil, false
+	}
+	packed, err := args.Pack(values...)
+	if err != nil || !bytes.Equal(packed, data) {
+		return nil, false
+	}
+	return values, true
+}
+
+// parseSignature parses the name and arguments of the text signature sig. The
+// arguments are named arg0, arg1 and so on.
+func parseSignature(sig string) (string, abi.Arguments, error) {
+	open := strings.Index(sig, "(")
+	if open <= 0 || !strings.HasSuffix(sig, ")") {
+		return "", nil, errInvalidSignature
+	}
+	types, err := splitTypes(sig[open+1 : len(sig)-1])
+	if err != nil {
+		return "", nil, err
+	}
+	args := make(abi.Arguments, len(types))
+	for i, typ := range types {
+		marshaling, err := argumentMarshaling(fmt.Sprintf("arg%d", i), typ)
+		if err != nil {
+			return "", nil, err
+		}
+		t, err := abi.NewType(marshaling.Type, "", marshaling.Components)
+		if err != nil {
+			return "", nil, err
+		}
+		args[i] = abi.Argument{Name: marshaling.Name, Type: t}
+	}
+	return sig[:open], args, nil
+}
+
+var errInvalidSignature = errors.New("signature is invalid")
+
+// argumentMarshaling describes the type typ, where a tuple is written as its
+// parenthesized component types.
+func argumentMarshaling(name, typ string) (abi.ArgumentMarshaling, error) {
+	if !strings.HasPrefix(typ, "(") {
+		return abi.ArgumentMarshaling{Name: name, Type: typ}, nil
+	}
+	end := strings.LastIndex(typ, ")")
+	types, err := splitTypes(typ[1:end])
+	if err != nil {
+		return abi.ArgumentMarshaling{}, err
+	}
+	components := make([]abi.ArgumentMarshaling, len(types))
+	for i, t := range types {
+		if components[i], err = argumentMarshaling(fmt.Sprintf("field%d", i), t); err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+	}
+	return abi.ArgumentMarshaling{Name: name, Type: "tuple" + typ[end+1:], Components: components}, nil
+}
+
+// splitTypes splits a comma-separated list of types, leaving the commas
+// inside tuples.
+func splitTypes(list string) ([]string, error) {
+	if list == "" {
+		return nil, nil
+	}
+	var types []string
+	var depth, start int
+	for i, c := range list {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errInvalidSignature
+			}
+		case ',':
+			if depth == 0 {
+				types = append(types, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errInvalidSignature
+	}
+	types = append(types, list[start:])
+	for _, t := range types {
+		if t == "" {
+			return nil, errInvalidSignature
+		}
+	}
+	return types, nil
+}
+
+func decodedParams(args abi.Arguments, values []interface{}) []DecodedParam {
+	params := make([]DecodedParam, len(args))
+	for i, arg := range args {
+		value := formatValue(reflect.ValueOf(values[i]))
+		s, ok := value.(string)
+		if !ok {
+			b, _ := json.Marshal(value)
+			s = string(b)
+		}
+		params[i] = DecodedParam{Name: arg.Name, Type: arg.Type.String(), Value: s}
+	}
+	return params
+}
+
+// formatValue converts a value unpacked by the abi package into strings,
+// slices and maps that read well as JSON.
+func formatValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		return value.String()
+	case common.Address:
+		return value.String()
+	case []byte:
+		return hexutil.Encode(value)
+	case string:
+		return value
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		return formatValue(v.Elem())
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		values := make([]interface{}, v.Len())
+		for i := range values {
+			values[i] = formatValue(v.Index(i))
+		}
+		return values
+	case reflect.Struct:
+		values := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			// tuple fields keep the name from the ABI as their json tag
+			field := v.Type().Field(i)
+			name := field.Tag.Get("json")
+			if name == "" {
+				name = field.Name
+			}
+			values[name] = formatValue(v.Field(i))
+		}
+		return values
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// Checkpoint records how far a long-running indexing job has progressed.
+type Checkpoint struct {
+	Name      string `gorm:"primaryKey"`
+	BlockNum  uint64
+	UpdatedAt time.Time
 }
 
 func (tx Transaction) MarshalBinary() (data []byte, err error) {
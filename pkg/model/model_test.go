@@ -0,0 +1,467 @@
+package model
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	testToken    = "0x00000000000000000000000000000000000000Aa"
+	testFrom     = common.HexToAddress("0x01").String()
+	testTo       = common.HexToAddress("0x02").String()
+	testOperator = common.HexToAddress("0x03").String()
+)
+
+// topic returns the address or number as a 32-byte topic.
+func topic(value string) string {
+	return common.HexToHash(value).String()
+}
+
+func TestNewTokenTransfer(t *testing.T) {
+	tests := []struct {
+		name string
+		log  Log
+		want *TokenTransfer
+	}{
+		{
+			name: "transfer",
+			log: Log{
+				TxHash:   "0xabc",
+				Index:    2,
+				BlockNum: 7,
+				Address:  testToken,
+				Topics:   []string{TransferTopic, topic(testFrom), topic(testTo)},
+				Data:     topic("0x0de0b6b3a7640000"),
+			},
+			want: &TokenTransfer{
+				TxHash:   "0xabc",
+				LogIndex: 2,
+				BlockNum: 7,
+				Token:    testToken,
+				FromAddr: testFrom,
+				ToAddr:   testTo,
+				Amount:   "1000000000000000000",
+			},
+		},
+		{
+			name: "erc-721 transfer",
+			log: Log{
+				Address: testToken,
+				Topics:  []string{TransferTopic, topic(testFrom), topic(testTo), topic("0x01")},
+				Data:    "0x",
+			},
+		},
+		{
+			name: "other event",
+			log: Log{
+				Address: testToken,
+				Topics:  []string{TransferSingleTopic, topic(testFrom), topic(testTo)},
+				Data:    topic("0x01"),
+			},
+		},
+		{
+			name: "short data",
+			log: Log{
+				Address: testToken,
+				Topics:  []string{TransferTopic, topic(testFrom), topic(testTo)},
+				Data:    "0x01",
+			},
+		},
+		{
+			name: "invalid data",
+			log: Log{
+				Address: testToken,
+				Topics:  []string{TransferTopic, topic(testFrom), topic(testTo)},
+				Data:    "0xzz",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NewTokenTransfer(&tt.log)
+			if ok != (tt.want != nil) {
+				t.Fatalf("NewTokenTransfer ok = %v, want %v", ok, tt.want != nil)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewTokenTransfer = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewNFTTransfers(t *testing.T) {
+	batch, err := uint256Arrays.Pack(
+		[]*big.Int{big.NewInt(1), big.NewInt(2)},
+		[]*big.Int{big.NewInt(10), big.NewInt(20)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unevenBatch, err := uint256Arrays.Pack([]*big.Int{big.NewInt(1)}, []*big.Int{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	single := hexutil.Encode(append(common.HexToHash("0x05").Bytes(), common.HexToHash("0x03").Bytes()...))
+	erc1155 := func(batchIndex uint, tokenID, amount string) *NFTTransfer {
+		return &NFTTransfer{
+			TxHash:     "0xabc",
+			LogIndex:   2,
+			BatchIndex: batchIndex,
+			BlockNum:   7,
+			Standard:   ERC1155,
+			Token:      testToken,
+			Operator:   testOperator,
+			FromAddr:   testFrom,
+			ToAddr:     testTo,
+			TokenID:    tokenID,
+			Amount:     amount,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		topics []string
+		data   string
+		want   []*NFTTransfer
+	}{
+		{
+			name:   "erc-721 transfer",
+			topics: []string{TransferTopic, topic(testFrom), topic(testTo), topic("0x2a")},
+			data:   "0x",
+			want: []*NFTTransfer{{
+				TxHash:   "0xabc",
+				LogIndex: 2,
+				BlockNum: 7,
+				Standard: ERC721,
+				Token:    testToken,
+				FromAddr: testFrom,
+				ToAddr:   testTo,
+				TokenID:  "42",
+				Amount:   "1",
+			}},
+		},
+		{
+			name:   "erc-721 transfer with data",
+			topics: []string{TransferTopic, topic(testFrom), topic(testTo), topic("0x2a")},
+			data:   topic("0x01"),
+		},
+		{
+			name:   "erc-20 transfer",
+			topics: []string{TransferTopic, topic(testFrom), topic(testTo)},
+			data:   topic("0x01"),
+		},
+		{
+			name:   "erc-1155 single transfer",
+			topics: []string{TransferSingleTopic, topic(testOperator), topic(testFrom), topic(testTo)},
+			data:   single,
+			want:   []*NFTTransfer{erc1155(0, "5", "3")},
+		},
+		{
+			name:   "erc-1155 single transfer with short data",
+			topics: []string{TransferSingleTopic, topic(testOperator), topic(testFrom), topic(testTo)},
+			data:   topic("0x05"),
+		},
+		{
+			name:   "erc-1155 batch transfer",
+			topics: []string{TransferBatchTopic, topic(testOperator), topic(testFrom), topic(testTo)},
+			data:   hexutil.Encode(batch),
+			want:   []*NFTTransfer{erc1155(0, "1", "10"), erc1155(1, "2", "20")},
+		},
+		{
+			name:   "erc-1155 batch transfer with uneven arrays",
+			topics: []string{TransferBatchTopic, topic(testOperator), topic(testFrom), topic(testTo)},
+			data:   hexutil.Encode(unevenBatch),
+		},
+		{
+			name:   "erc-1155 batch transfer with invalid data",
+			topics: []string{TransferBatchTopic, topic(testOperator), topic(testFrom), topic(testTo)},
+			data:   topic("0x01"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewNFTTransfers(&Log{
+				TxHash:   "0xabc",
+				Index:    2,
+				BlockNum: 7,
+				Address:  testToken,
+				Topics:   tt.topics,
+				Data:     tt.data,
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewNFTTransfers = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogFilterMatch(t *testing.T) {
+	log := &Log{
+		Address: testToken,
+		Topics:  []string{TransferTopic, topic(testFrom), topic(testTo)},
+	}
+	other := topic("0x09")
+
+	tests := []struct {
+		name   string
+		filter LogFilter
+		want   bool
+	}{
+		{"empty filter", LogFilter{}, true},
+		{"address", LogFilter{Addresses: []string{testTo, testToken}}, true},
+		{"other address", LogFilter{Addresses: []string{testTo}}, false},
+		{"first topic", LogFilter{Topics: [][]string{{TransferTopic}}}, true},
+		{"any of the topics", LogFilter{Topics: [][]string{{other, TransferTopic}}}, true},
+		{"other topic", LogFilter{Topics: [][]string{{other}}}, false},
+		{"wildcard position", LogFilter{Topics: [][]string{{}, {topic(testFrom)}}}, true},
+		{"topic at the wrong position", LogFilter{Topics: [][]string{{}, {topic(testTo)}}}, false},
+		{"wildcard past the topics", LogFilter{Topics: [][]string{{}, {}, {}, {}}}, true},
+		{"topic past the topics", LogFilter{Topics: [][]string{{}, {}, {}, {other}}}, false},
+		{"address and topics", LogFilter{Addresses: []string{testToken}, Topics: [][]string{{TransferTopic}, {}, {topic(testTo)}}}, true},
+		{"address but not topics", LogFilter{Addresses: []string{testToken}, Topics: [][]string{{TransferSingleTopic}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(log); got != tt.want {
+				t.Errorf("Match = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTxFilterMatch(t *testing.T) {
+	tx := &Transaction{FromAddr: testFrom, ToAddr: testTo}
+
+	tests := []struct {
+		name   string
+		filter TxFilter
+		want   bool
+	}{
+		{"empty filter", TxFilter{}, true},
+		{"empty filter with a direction", TxFilter{Direction: DirectionIn}, true},
+		{"sender", TxFilter{Addresses: []string{testFrom}, Direction: DirectionBoth}, true},
+		{"recipient", TxFilter{Addresses: []string{testToken, testTo}, Direction: DirectionBoth}, true},
+		{"other address", TxFilter{Addresses: []string{testToken}, Direction: DirectionBoth}, false},
+		{"sender out", TxFilter{Addresses: []string{testFrom}, Direction: DirectionOut}, true},
+		{"recipient out", TxFilter{Addresses: []string{testTo}, Direction: DirectionOut}, false},
+		{"recipient in", TxFilter{Addresses: []string{testTo}, Direction: DirectionIn}, true},
+		{"sender in", TxFilter{Addresses: []string{testFrom}, Direction: DirectionIn}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(tx); got != tt.want {
+				t.Errorf("Match = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsFinality(t *testing.T) {
+	tests := []struct {
+		finality string
+		want     bool
+	}{
+		{FinalityLatest, true},
+		{FinalitySafe, true},
+		{FinalityFinalized, true},
+		{"", false},
+		{"pending", false},
+		{"Safe", false},
+	}
+	for _, tt := range tests {
+		if got := IsFinality(tt.finality); got != tt.want {
+			t.Errorf("IsFinality(%q) = %v, want %v", tt.finality, got, tt.want)
+		}
+	}
+}
+
+func TestReachesFinality(t *testing.T) {
+	tests := []struct {
+		finality string
+		want     string
+		reaches  bool
+	}{
+		{FinalityLatest, "", true},
+		{FinalityLatest, FinalityLatest, true},
+		{FinalityLatest, FinalitySafe, false},
+		{FinalityLatest, FinalityFinalized, false},
+		{FinalitySafe, FinalityLatest, true},
+		{FinalitySafe, FinalitySafe, true},
+		{FinalitySafe, FinalityFinalized, false},
+		{FinalityFinalized, "", true},
+		{FinalityFinalized, FinalitySafe, true},
+		{FinalityFinalized, FinalityFinalized, true},
+	}
+	for _, tt := range tests {
+		if got := ReachesFinality(tt.finality, tt.want); got != tt.reaches {
+			t.Errorf("ReachesFinality(%q, %q) = %v, want %v", tt.finality, tt.want, got, tt.reaches)
+		}
+	}
+}
+
+func TestDecodeCall(t *testing.T) {
+	contract, err := abi.JSON(strings.NewReader(`[
+		{"type": "function", "name": "transfer", "inputs": [
+			{"name": "to", "type": "address"},
+			{"name": "amount", "type": "uint256"}
+		]},
+		{"type": "function", "name": "swap", "inputs": [
+			{"name": "", "type": "address"},
+			{"name": "", "type": "uint256"}
+		]}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pack := func(method string, args ...interface{}) string {
+		data, err := contract.Pack(method, args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hexutil.Encode(data)
+	}
+
+	tests := []struct {
+		name string
+		data string
+		want *Decoded
+	}{
+		{
+			name: "named inputs",
+			data: pack("transfer", common.HexToAddress(testTo), big.NewInt(5)),
+			want: &Decoded{
+				Name:      "transfer",
+				Signature: "transfer(address,uint256)",
+				Params: []DecodedParam{
+					{Name: "to", Type: "address", Value: testTo},
+					{Name: "amount", Type: "uint256", Value: "5"},
+				},
+			},
+		},
+		{
+			name: "unnamed inputs",
+			data: pack("swap", common.HexToAddress(testTo), big.NewInt(5)),
+			want: &Decoded{
+				Name:      "swap",
+				Signature: "swap(address,uint256)",
+				Params: []DecodedParam{
+					{Name: "", Type: "address", Value: testTo},
+					{Name: "", Type: "uint256", Value: "5"},
+				},
+			},
+		},
+		{
+			name: "unknown method",
+			data: "0x12345678",
+		},
+		{
+			name: "short data",
+			data: "0x1234",
+		},
+		{
+			name: "truncated arguments",
+			data: pack("transfer", common.HexToAddress(testTo), big.NewInt(5))[:74],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DecodeCall(&contract, tt.data); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeCall = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSignature(t *testing.T) {
+	tests := []struct {
+		sig   string
+		name  string
+		types []string
+		err   bool
+	}{
+		{sig: "transfer(address,uint256)", name: "transfer", types: []string{"address", "uint256"}},
+		{sig: "pause()", name: "pause", types: []string{}},
+		{sig: "f(uint256[],bytes32[2])", name: "f", types: []string{"uint256[]", "bytes32[2]"}},
+		{sig: "f((address,uint256)[],bytes)", name: "f", types: []string{"(address,uint256)[]", "bytes"}},
+		{sig: "f((address,(uint8,bool)),string)", name: "f", types: []string{"(address,(uint8,bool))", "string"}},
+		{sig: "transfer", err: true},
+		{sig: "(uint256)", err: true},
+		{sig: "f(uint256", err: true},
+		{sig: "f((uint256)", err: true},
+		{sig: "f(uint256))", err: true},
+		{sig: "f(foo)", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sig, func(t *testing.T) {
+			name, args, err := parseSignature(tt.sig)
+			if (err != nil) != tt.err {
+				t.Fatalf("parseSignature error = %v, want error %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if name != tt.name {
+				t.Errorf("name = %q, want %q", name, tt.name)
+			}
+			types := []string{}
+			for i, arg := range args {
+				if want := fmt.Sprintf("arg%d", i); arg.Name != want {
+					t.Errorf("arg %d name = %q, want %q", i, arg.Name, want)
+				}
+				types = append(types, arg.Type.String())
+			}
+			if !reflect.DeepEqual(types, tt.types) {
+				t.Errorf("types = %v, want %v", types, tt.types)
+			}
+		})
+	}
+}
+
+func TestUnpackExact(t *testing.T) {
+	_, args, err := parseSignature("f(address,uint256[])")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := args.Pack(common.HexToAddress(testTo), []*big.Int{big.NewInt(1), big.NewInt(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dirty := append([]byte{}, data...)
+	dirty[0] = 0xff // an address padded with other than zeros
+
+	tests := []struct {
+		name string
+		data []byte
+		ok   bool
+	}{
+		{"exact", data, true},
+		{"bytes left over", append(append([]byte{}, data...), make([]byte, 32)...), false},
+		{"truncated", data[:len(data)-32], false},
+		{"dirty padding", dirty, false},
+		{"empty", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, ok := unpackExact(args, tt.data)
+			if ok != tt.ok {
+				t.Fatalf("unpackExact ok = %v, want %v", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if values[0] != common.HexToAddress(testTo) {
+				t.Errorf("arg0 = %v, want %s", values[0], testTo)
+			}
+			if got := values[1].([]*big.Int); len(got) != 2 || got[0].Int64() != 1 || got[1].Int64() != 2 {
+				t.Errorf("arg1 = %v, want [1 2]", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,422 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	maxScore     = 10
+	initialScore = 5
+	successScore = 1
+	failureScore = 3
+
+	// an endpoint whose head is more than maxLag blocks behind the highest
+	// head in the pool is demoted
+	maxLag = 5
+
+	healthCheckInterval = time.Second * 5
+	healthCheckTimeout  = time.Second * 3
+)
+
+var (
+	ErrNoEndpoint = errors.New("no endpoint available")
+	ErrNoQuorum   = errors.New("endpoints do not reach a quorum")
+)
+
+// Pool is a set of upstream JSON-RPC endpoints. Every call goes to the
+// healthiest endpoint and fails over to the next one when it errors.
+type Pool struct {
+	mu        sync.RWMutex
+	endpoints []*endpoint
+	quorum    int
+}
+
+type endpoint struct {
+	url     string
+	client  *ethclient.Client
+	score   int
+	head    uint64
+	lagging bool
+}
+
+// Dial connects to the endpoints and starts checking their health. An
+// endpoint that cannot be dialed yet is retried by the health check, so the
+// pool starts even when none is up and calls fail with ErrNoEndpoint until
+// one is. quorum is the number of endpoints that must agree on a block hash
+// in HeaderByNumber and CheckHashes.
+func Dial(urls []string, quorum int) (*Pool, error) {
+	p := &Pool{quorum: quorum}
+	for _, url := range urls {
+		url = strings.TrimSpace(url)
+		if url == "" {
+			continue
+		}
+		p.endpoints = append(p.endpoints, &endpoint{url: url, score: initialScore})
+	}
+	if len(p.endpoints) == 0 {
+		return nil, ErrNoEndpoint
+	}
+	if quorum > len(p.endpoints) {
+		return nil, ErrNoQuorum
+	}
+
+	p.checkHealth()
+	if len(p.available()) == 0 {
+		log.Printf("no endpoint is up yet, retrying every %v", healthCheckInterval)
+	}
+	go func() {
+		for range time.Tick(healthCheckInterval) {
+			p.checkHealth()
+		}
+	}()
+	return p, nil
+}
+
+// checkHealth dials the endpoints that are not connected, updates the head
+// and score of every endpoint and demotes the ones lagging behind.
+func (p *Pool) checkHealth() {
+	var wg sync.WaitGroup
+	heads := make([]uint64, len(p.endpoints))
+	errs := make([]error, len(p.endpoints))
+	for i, e := range p.endpoints {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+			defer cancel()
+
+			client := p.client(e)
+			if client == nil {
+				c, err := ethclient.DialContext(ctx, e.url)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				p.mu.Lock()
+				e.client = c
+				p.mu.Unlock()
+				client = c
+			}
+			heads[i], errs[i] = client.BlockNumber(ctx)
+		}(i, e)
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var maxHead uint64
+	for i, e := range p.endpoints {
+		if errs[i] != nil {
+			log.Printf("endpoint %s is unhealthy: %v", e.url, errs[i])
+			e.score = max(e.score-failureScore, 0)
+			continue
+		}
+		e.score = min(e.score+successScore, maxScore)
+		e.head = heads[i]
+		maxHead = max(maxHead, e.head)
+	}
+	for _, e := range p.endpoints {
+		lagging := e.head+maxLag < maxHead
+		if lagging && !e.lagging {
+			log.Printf("endpoint %s is lagging at block %d behind %d", e.url, e.head, maxHead)
+		}
+		e.lagging = lagging
+	}
+}
+
+func (p *Pool) client(e *endpoint) *ethclient.Client {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return e.client
+}
+
+// available lists the connected endpoints, the ones in sync before the lagging
+// ones, each ordered by score.
+func (p *Pool) available() []*endpoint {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	endpoints := make([]*endpoint, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		if e.client != nil {
+			endpoints = append(endpoints, e)
+		}
+	}
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		if endpoints[i].lagging != endpoints[j].lagging {
+			return !endpoints[i].lagging
+		}
+		return endpoints[i].score > endpoints[j].score
+	})
+	return endpoints
+}
+
+func (p *Pool) report(e *endpoint, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err == nil {
+		e.score = min(e.score+successScore, maxScore)
+		return
+	}
+	e.score = max(e.score-failureScore, 0)
+}
+
+// do runs fn against the available endpoints in order until one succeeds.
+// Not found results and cancelled contexts are returned as they are, as another
+// endpoint would not do better.
+func (p *Pool) do(ctx context.Context, fn func(*ethclient.Client) error) error {
+	err := ErrNoEndpoint
+	for _, e := range p.available() {
+		err = fn(e.client)
+		if err == nil || err == ethereum.NotFound || ctx.Err() != nil {
+			p.report(e, nil)
+			return err
+		}
+		log.Printf("endpoint %s failed, failing over: %v", e.url, err)
+		p.report(e, err)
+	}
+	return err
+}
+
+// CanSubscribe reports whether any endpoint supports subscriptions, i.e. is a
+// websocket or IPC endpoint.
+func (p *Pool) CanSubscribe() bool {
+	for _, e := range p.endpoints {
+		if canSubscribe(e.url) {
+			return true
+		}
+	}
+	return false
+}
+
+func canSubscribe(url string) bool {
+	return !strings.HasPrefix(url, "http")
+}
+
+func (p *Pool) ChainID(ctx context.Context) (*big.Int, error) {
+	var chainID *big.Int
+	err := p.do(ctx, func(c *ethclient.Client) (err error) {
+		chainID, err = c.ChainID(ctx)
+		return err
+	})
+	return chainID, err
+}
+
+func (p *Pool) BlockNumber(ctx context.Context) (uint64, error) {
+	var num uint64
+	err := p.do(ctx, func(c *ethclient.Client) (err error) {
+		num, err = c.BlockNumber(ctx)
+		return err
+	})
+	return num, err
+}
+
+// HeaderByNumber returns the header of the block. With a quorum above one,
+// the header is only returned once that many endpoints agree on its hash. The
+// endpoints may be a block or so apart, so the latest block or another tag is
+// first resolved to the highest number that quorum endpoints have reached.
+func (p *Pool) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if p.quorum <= 1 {
+		var header *types.Header
+		err := p.do(ctx, func(c *ethclient.Client) (err error) {
+			header, err = c.HeaderByNumber(ctx, number)
+			return err
+		})
+		return header, err
+	}
+
+	if number == nil || number.Sign() < 0 {
+		var err error
+		if number, err = p.quorumNumber(ctx, number); err != nil {
+			return nil, err
+		}
+	}
+	votes := make(map[common.Hash]int)
+	for _, e := range p.available() {
+		header, err := e.client.HeaderByNumber(ctx, number)
+		p.report(e, err)
+		if err != nil {
+			continue
+		}
+		hash := header.Hash()
+		votes[hash]++
+		if votes[hash] >= p.quorum {
+			return header, nil
+		}
+	}
+	return nil, ErrNoQuorum
+}
+
+// quorumNumber resolves the block tag on every endpoint and returns the
+// highest block number that quorum of them have reached.
+func (p *Pool) quorumNumber(ctx context.Context, tag *big.Int) (*big.Int, error) {
+	var nums []uint64
+	for _, e := range p.available() {
+		header, err := e.client.HeaderByNumber(ctx, tag)
+		p.report(e, err)
+		if err != nil {
+			continue
+		}
+		nums = append(nums, header.Number.Uint64())
+	}
+	if len(nums) < p.quorum {
+		return nil, ErrNoQuorum
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] > nums[j] })
+	return new(big.Int).SetUint64(nums[p.quorum-1]), nil
+}
+
+// CheckHashes checks the hashes of blocks fetched by number, e.g. with
+// BatchCallContext from a single endpoint, against the other endpoints. The
+// error of a block is ErrNoQuorum until quorum endpoints agree on its hash.
+// With a quorum of one, every block is taken as it is.
+func (p *Pool) CheckHashes(ctx context.Context, nums []uint64, hashes []common.Hash) []error {
+	errs := make([]error, len(nums))
+	if p.quorum <= 1 {
+		return errs
+	}
+
+	votes := make([]int, len(nums))
+	for _, e := range p.available() {
+		headers := make([]*struct{ Hash common.Hash }, len(nums))
+		batch := make([]rpc.BatchElem, len(nums))
+		for i, num := range nums {
+			batch[i] = rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []interface{}{hexutil.EncodeUint64(num), false},
+				Result: &headers[i],
+			}
+		}
+		err := e.client.Client().BatchCallContext(ctx, batch)
+		p.report(e, err)
+		if err != nil {
+			continue
+		}
+		for i := range batch {
+			if batch[i].Error == nil && headers[i] != nil && headers[i].Hash == hashes[i] {
+				votes[i]++
+			}
+		}
+	}
+	for i := range errs {
+		if votes[i] < p.quorum {
+			errs[i] = ErrNoQuorum
+		}
+	}
+	return errs
+}
+
+func (p *Pool) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	var header *types.Header
+	err := p.do(ctx, func(c *ethclient.Client) (err error) {
+		header, err = c.HeaderByHash(ctx, hash)
+		return err
+	})
+	return header, err
+}
+
+func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	var block *types.Block
+	err := p.do(ctx, func(c *ethclient.Client) (err error) {
+		block, err = c.BlockByNumber(ctx, number)
+		return err
+	})
+	return block, err
+}
+
+func (p *Pool) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	var tx *types.Transaction
+	var isPending bool
+	err := p.do(ctx, func(c *ethclient.Client) (err error) {
+		tx, isPending, err = c.TransactionByHash(ctx, hash)
+		return err
+	})
+	return tx, isPending, err
+}
+
+func (p *Pool) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	var receipt *types.Receipt
+	err := p.do(ctx, func(c *ethclient.Client) (err error) {
+		receipt, err = c.TransactionReceipt(ctx, hash)
+		return err
+	})
+	return receipt, err
+}
+
+// BatchCallContext sends the batch to one endpoint, failing over when the
+// request as a whole fails. Errors of single calls are left in the elements.
+func (p *Pool) BatchCallContext(ctx context.Context, batch []rpc.BatchElem) error {
+	return p.do(ctx, func(c *ethclient.Client) error {
+		return c.Client().BatchCallContext(ctx, batch)
+	})
+}
+
+// CallContext sends a JSON-RPC call to one endpoint, failing over when the
+// endpoint cannot answer. An error answered by the endpoint, such as a reverted
+// eth_call, is returned as it is.
+func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	var callErr error
+	err := p.do(ctx, func(c *ethclient.Client) error {
+		callErr = c.Client().CallContext(ctx, result, method, args...)
+		var rpcErr rpc.Error
+		if errors.As(callErr, &rpcErr) {
+			return nil
+		}
+		return callErr
+	})
+	if err != nil {
+		return err
+	}
+	return callErr
+}
+
+// SubscribeNewHead subscribes to new heads on the healthiest endpoint that
+// supports subscriptions.
+func (p *Pool) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	var sub ethereum.Subscription
+	err := ErrNoEndpoint
+	for _, e := range p.available() {
+		if !canSubscribe(e.url) {
+			continue
+		}
+		sub, err = e.client.SubscribeNewHead(ctx, ch)
+		p.report(e, err)
+		if err == nil {
+			return sub, nil
+		}
+	}
+	return nil, err
+}
+
+// SubscribePendingTransactions subscribes to the transactions entering the
+// pool of the healthiest endpoint that supports subscriptions. The endpoint
+// must send the full transactions of newPendingTransactions, not only their
+// hashes.
+func (p *Pool) SubscribePendingTransactions(ctx context.Context, ch chan<- *types.Transaction) (ethereum.Subscription, error) {
+	var sub ethereum.Subscription
+	err := ErrNoEndpoint
+	for _, e := range p.available() {
+		if !canSubscribe(e.url) {
+			continue
+		}
+		sub, err = e.client.Client().EthSubscribe(ctx, ch, "newPendingTransactions", true)
+		p.report(e, err)
+		if err == nil {
+			return sub, nil
+		}
+	}
+	return nil, err
+}
@@ -0,0 +1,294 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// fakeNode serves the eth methods the pool calls for a chain of empty blocks
+// up to head. Blocks from fork on differ from the ones of other nodes.
+type fakeNode struct {
+	head uint64
+	fork uint64
+	down bool
+}
+
+var errDown = errors.New("node is down")
+
+func (n *fakeNode) header(num uint64) *types.Header {
+	header := &types.Header{Number: new(big.Int).SetUint64(num), Difficulty: common.Big0}
+	if n.fork != 0 && num >= n.fork {
+		header.Extra = []byte("fork")
+	}
+	return header
+}
+
+func (n *fakeNode) ChainId() (*hexutil.Big, error) {
+	if n.down {
+		return nil, errDown
+	}
+	return (*hexutil.Big)(big.NewInt(1)), nil
+}
+
+func (n *fakeNode) BlockNumber() (hexutil.Uint64, error) {
+	if n.down {
+		return 0, errDown
+	}
+	return hexutil.Uint64(n.head), nil
+}
+
+func (n *fakeNode) GetBlockByNumber(num rpc.BlockNumber, full bool) (*types.Header, error) {
+	if n.down {
+		return nil, errDown
+	}
+	if num < 0 {
+		return n.header(n.head), nil
+	}
+	if uint64(num) > n.head {
+		return nil, nil
+	}
+	return n.header(uint64(num)), nil
+}
+
+// startNodes serves the nodes over HTTP and returns their URLs.
+func startNodes(t *testing.T, nodes ...*fakeNode) []string {
+	urls := make([]string, len(nodes))
+	for i, n := range nodes {
+		server := rpc.NewServer()
+		if err := server.RegisterName("eth", n); err != nil {
+			t.Fatal(err)
+		}
+		ts := httptest.NewServer(server)
+		t.Cleanup(ts.Close)
+		t.Cleanup(server.Stop)
+		urls[i] = ts.URL
+	}
+	return urls
+}
+
+func TestPoolFailover(t *testing.T) {
+	first, second := &fakeNode{head: 10}, &fakeNode{head: 10}
+	p, err := Dial(startNodes(t, first, second), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first.down = true
+	for i := 0; i < 2; i++ {
+		chainID, err := p.ChainID(context.Background())
+		if err != nil {
+			t.Fatalf("ChainID failed: %v", err)
+		}
+		if chainID.Int64() != 1 {
+			t.Errorf("ChainID = %v, want 1", chainID)
+		}
+	}
+	if got := p.available()[0]; got.url != p.endpoints[1].url {
+		t.Errorf("healthiest endpoint = %s, want the one up %s", got.url, p.endpoints[1].url)
+	}
+
+	second.down = true
+	if _, err := p.ChainID(context.Background()); err == nil {
+		t.Error("ChainID succeeded with every endpoint down")
+	}
+}
+
+func TestPoolLagging(t *testing.T) {
+	behind, synced := &fakeNode{head: 10}, &fakeNode{head: 10 + maxLag + 1}
+	p, err := Dial(startNodes(t, behind, synced), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	num, err := p.BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num != synced.head {
+		t.Errorf("BlockNumber = %d, want %d from the endpoint in sync", num, synced.head)
+	}
+}
+
+func TestDialWithoutEndpointUp(t *testing.T) {
+	n := &fakeNode{head: 10, down: true}
+	p, err := Dial(startNodes(t, n), 1)
+	if err != nil {
+		t.Fatalf("Dial failed with no endpoint up: %v", err)
+	}
+	if _, err := p.ChainID(context.Background()); err == nil {
+		t.Error("ChainID succeeded with no endpoint up")
+	}
+
+	n.down = false
+	p.checkHealth()
+	if _, err := p.ChainID(context.Background()); err != nil {
+		t.Errorf("ChainID failed once the endpoint is up: %v", err)
+	}
+
+	if _, err := Dial(nil, 1); err != ErrNoEndpoint {
+		t.Errorf("Dial without endpoints = %v, want %v", err, ErrNoEndpoint)
+	}
+	if _, err := Dial([]string{"http://localhost:1"}, 2); err != ErrNoQuorum {
+		t.Errorf("Dial with a quorum above the endpoints = %v, want %v", err, ErrNoQuorum)
+	}
+}
+
+func TestHeaderByNumberQuorum(t *testing.T) {
+	tests := []struct {
+		name   string
+		nodes  []*fakeNode
+		quorum int
+		number *big.Int
+		want   uint64
+		err    error
+	}{
+		{
+			name:   "latest one block apart",
+			nodes:  []*fakeNode{{head: 11}, {head: 10}},
+			quorum: 2,
+			want:   10,
+		},
+		{
+			name:   "latest reached by the quorum",
+			nodes:  []*fakeNode{{head: 12}, {head: 11}, {head: 11}},
+			quorum: 2,
+			want:   11,
+		},
+		{
+			name:   "by number",
+			nodes:  []*fakeNode{{head: 12}, {head: 11}},
+			quorum: 2,
+			number: big.NewInt(5),
+			want:   5,
+		},
+		{
+			name:   "forked",
+			nodes:  []*fakeNode{{head: 12}, {head: 12, fork: 5}},
+			quorum: 2,
+			number: big.NewInt(5),
+			err:    ErrNoQuorum,
+		},
+		{
+			name:   "not reached by the quorum",
+			nodes:  []*fakeNode{{head: 12}, {head: 12, down: true}},
+			quorum: 2,
+			err:    ErrNoQuorum,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Dial(startNodes(t, tt.nodes...), tt.quorum)
+			if err != nil {
+				t.Fatal(err)
+			}
+			header, err := p.HeaderByNumber(context.Background(), tt.number)
+			if err != tt.err {
+				t.Fatalf("HeaderByNumber error = %v, want %v", err, tt.err)
+			}
+			if err == nil && header.Number.Uint64() != tt.want {
+				t.Errorf("HeaderByNumber = block %d, want %d", header.Number, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckHashes(t *testing.T) {
+	honest := &fakeNode{head: 12}
+	p, err := Dial(startNodes(t, honest, &fakeNode{head: 12}, &fakeNode{head: 12, fork: 6}), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nums := []uint64{5, 6, 13}
+	hashes := []common.Hash{honest.header(5).Hash(), honest.header(6).Hash(), {}}
+	errs := p.CheckHashes(context.Background(), nums, hashes)
+	want := []error{nil, nil, ErrNoQuorum}
+	for i := range want {
+		if errs[i] != want[i] {
+			t.Errorf("block %d: error = %v, want %v", nums[i], errs[i], want[i])
+		}
+	}
+
+	// the forked block is only on one endpoint
+	forked := (&fakeNode{fork: 6}).header(6).Hash()
+	if errs := p.CheckHashes(context.Background(), []uint64{6}, []common.Hash{forked}); errs[0] != ErrNoQuorum {
+		t.Errorf("forked block: error = %v, want %v", errs[0], ErrNoQuorum)
+	}
+}
+
+// pendingNode announces its pending transactions to subscribers asking for
+// the full transactions.
+type pendingNode struct {
+	fakeNode
+	pending []*types.Transaction
+}
+
+func (n *pendingNode) NewPendingTransactions(ctx context.Context, full *bool) (*rpc.Subscription, error) {
+	if full == nil || !*full {
+		return nil, errors.New("only full transactions are sent")
+	}
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+	go func() {
+		for _, tx := range n.pending {
+			notifier.Notify(sub.ID, tx)
+		}
+	}()
+	return sub, nil
+}
+
+func TestSubscribePendingTransactions(t *testing.T) {
+	to := common.HexToAddress("0x02")
+	n := &pendingNode{fakeNode: fakeNode{head: 10}, pending: []*types.Transaction{
+		types.NewTx(&types.LegacyTx{Nonce: 1, Gas: 21000, To: &to}),
+		types.NewTx(&types.LegacyTx{Nonce: 2, Gas: 21000, To: &to}),
+	}}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", n); err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	defer ts.Close()
+	defer server.Stop()
+
+	// an HTTP endpoint cannot subscribe
+	p, err := Dial([]string{ts.URL}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.SubscribePendingTransactions(context.Background(), make(chan *types.Transaction)); err == nil {
+		t.Error("SubscribePendingTransactions succeeded over HTTP")
+	}
+
+	p, err = Dial([]string{"ws" + strings.TrimPrefix(ts.URL, "http")}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txs := make(chan *types.Transaction)
+	sub, err := p.SubscribePendingTransactions(context.Background(), txs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+	for _, want := range n.pending {
+		select {
+		case tx := <-txs:
+			if tx.Hash() != want.Hash() {
+				t.Errorf("pending transaction = %s, want %s", tx.Hash(), want.Hash())
+			}
+		case err := <-sub.Err():
+			t.Fatalf("subscription dropped: %v", err)
+		case <-time.After(time.Second * 5):
+			t.Fatal("pending transaction not received")
+		}
+	}
+}
@@ -16,10 +16,35 @@ import (
 )
 
 type Repo interface {
-	CreateBlocks(block ...*model.Block) error
+	CreateBlocks(block ...*model.Block) ([]*model.Block, error)
+	GetBlock(num uint64) (*model.Block, error)
+	GetBlockByHash(hash string, orphaned bool) (*model.Block, error)
+	GetBlockByTime(timestamp uint64, before bool) (*model.Block, error)
+	ListBlocksByNumber(nums []uint64) ([]*model.Block, error)
 	GetTransaction(txHash string) (*model.Transaction, error)
+	ListBlockTransactions(num uint64) ([]*model.Transaction, error)
+	ListOrphanedTransactions(blockHash string) ([]*model.Transaction, error)
+	ListTransactions(txHashes []string) ([]*model.Transaction, error)
 	CreateTransaction(tx *model.Transaction) error
-	UpdateTransactionLogs(tx *model.Transaction) error
+	CreateReceipt(receipt *model.Receipt) error
+	UpdateTransaction(tx *model.Transaction) error
+	ListIncompleteTransactions(afterTxHash string, limit int) ([]*model.Transaction, error)
+	ListAddressTransactions(filter *model.AddressTxFilter) ([]*model.Transaction, error)
+	ListLogs(filter *model.LogFilter) ([]*model.Log, error)
+	ListInternalTransactions(txHash string) ([]*model.InternalTransaction, error)
+	ListAddressInternalTransactions(filter *model.InternalTxFilter) ([]*model.InternalTransaction, error)
+	CreateTokenTransfers(transfers ...*model.TokenTransfer) error
+	ListTokenTransfers(filter *model.TokenTransferFilter) ([]*model.TokenTransfer, error)
+	CreateNFTTransfers(transfers ...*model.NFTTransfer) error
+	ListNFTOwners(token, tokenID string) ([]*model.NFTHolding, error)
+	ListNFTInventory(owner string, limit int) ([]*model.NFTHolding, error)
+	SetContractABI(address, abi string) error
+	ListContractABIs(addresses ...string) ([]*model.ContractABI, error)
+	CreateSignatures(signatures ...*model.Signature) error
+	ListSignatures(selectors, topics []string) ([]*model.Signature, error)
+	GetCheckpoint(name string) (*model.Checkpoint, error)
+	SetCheckpoint(name string, num uint64) error
+	UpdateFinality(safeNum, finalizedNum uint64) error
 
 	ListBlocks(ctx context.Context, fromNum, toNum uint64) ([]*model.Block, error)
 	GetBlockNumber(ctx context.Context) (uint64, error)
@@ -29,6 +54,7 @@ type Repo interface {
 	DelBlockCache(ctx context.Context, block ...*model.Block) error
 	GetTxCache(ctx context.Context, txHash string) (*model.Transaction, error)
 	SetTxCache(ctx context.Context, txHash string, tx *model.Transaction) error
+	DelTxCache(ctx context.Context, txHash ...string) error
 
 	LockBlockNumber(ctx context.Context) (bool, error)
 	UnlockBlockNumber(ctx context.Context) error
@@ -89,13 +115,168 @@ func (repo *repo) ListBlocks(ctx context.Context, fromNum, toNum uint64) ([]*mod
 	return blocks, nil
 }
 
-func (repo *repo) CreateBlocks(block ...*model.Block) error {
-	return repo.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&block).Error
+// CreateBlocks stores the blocks with their transactions, receipts and logs.
+// A stored block at the same height with a different hash was reorged out, so
+// it is moved to the orphan tables along with every stored block above it, in
+// the same database transaction. The orphaned blocks are returned.
+func (repo *repo) CreateBlocks(block ...*model.Block) ([]*model.Block, error) {
+	var orphaned []*model.Block
+	err := repo.db.Transaction(func(db *gorm.DB) error {
+		nums := make([]uint64, len(block))
+		hashes := make(map[uint64]string, len(block))
+		for i, b := range block {
+			nums[i] = b.BlockNum
+			hashes[b.BlockNum] = b.BlockHash
+		}
+
+		var stored []*model.Block
+		if err := db.Where("block_num IN ?", nums).Find(&stored).Error; err != nil {
+			return err
+		}
+		var forkNum *uint64
+		for _, b := range stored {
+			if b.BlockHash != hashes[b.BlockNum] && (forkNum == nil || b.BlockNum < *forkNum) {
+				num := b.BlockNum
+				forkNum = &num
+			}
+		}
+		if forkNum != nil {
+			var err error
+			if orphaned, err = orphanBlocks(db, *forkNum); err != nil {
+				return err
+			}
+		}
+
+		return db.Session(&gorm.Session{FullSaveAssociations: true}).
+			Clauses(clause.OnConflict{UpdateAll: true}).Create(&block).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orphaned, nil
+}
+
+// orphanBlocks moves the blocks from fromNum upwards and their transactions to
+// the orphan tables and deletes them with their receipts and logs. The blocks
+// are returned with their transactions, receipts and logs.
+func orphanBlocks(db *gorm.DB, fromNum uint64) ([]*model.Block, error) {
+	var blocks []*model.Block
+	err := db.Preload("Transactions").Preload("Transactions.Receipt").
+		Preload("Transactions.Receipt.Logs", func(db *gorm.DB) *gorm.DB {
+			return db.Order("log_index")
+		}).Where("block_num >= ?", fromNum).Find(&blocks).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range blocks {
+		b.Orphaned = true
+		b.TxHash = make([]string, len(b.Transactions))
+		for i, tx := range b.Transactions {
+			b.TxHash[i] = tx.TxHash
+		}
+	}
+
+	for _, sql := range []string{
+		`INSERT INTO orphaned_blocks (block_hash, block_num, block_time, parent_hash)
+			SELECT block_hash, block_num, block_time, parent_hash FROM blocks
+			WHERE block_num >= ? ON CONFLICT DO NOTHING`,
+		`INSERT INTO orphaned_transactions (tx_hash, block_hash, block_num, tx)
+			SELECT t.tx_hash, b.block_hash, t.block_num, row_to_json(t)
+			FROM transactions t JOIN blocks b ON b.block_num = t.block_num
+			WHERE t.block_num >= ? ON CONFLICT DO NOTHING`,
+		`DELETE FROM internal_transactions WHERE block_num >= ?`,
+		`DELETE FROM token_transfers WHERE block_num >= ?`,
+		`DELETE FROM nft_transfers WHERE block_num >= ?`,
+		`DELETE FROM logs WHERE block_num >= ?`,
+		`DELETE FROM receipts WHERE block_num >= ?`,
+		`DELETE FROM transactions WHERE block_num >= ?`,
+		`DELETE FROM blocks WHERE block_num >= ?`,
+	} {
+		if err := db.Exec(sql, fromNum).Error; err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+func (repo *repo) GetBlock(num uint64) (*model.Block, error) {
+	var block *model.Block
+	err := repo.db.Where("block_num = ?", num).First(&block).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// GetBlockByHash returns the stored block with the hash. With orphaned, a
+// block that was reorged out is returned too, marked as orphaned and with the
+// hashes of its transactions.
+func (repo *repo) GetBlockByHash(hash string, orphaned bool) (*model.Block, error) {
+	var block *model.Block
+	err := repo.db.Where("block_hash = ?", hash).First(&block).Error
+	if err == nil {
+		return block, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if !orphaned {
+		return nil, ErrNotFound
+	}
+
+	err = repo.db.Table("orphaned_blocks").Where("block_hash = ?", hash).Take(&block).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = repo.db.Table("orphaned_transactions").Where("block_hash = ?", hash).
+		Order("tx_hash").Pluck("tx_hash", &block.TxHash).Error
+	if err != nil {
+		return nil, err
+	}
+	block.Orphaned = true
+	return block, nil
+}
+
+// GetBlockByTime returns the last block mined at or before the timestamp, or
+// with before unset, the first block mined at or after it.
+func (repo *repo) GetBlockByTime(timestamp uint64, before bool) (*model.Block, error) {
+	db := repo.db.Where("block_time >= ?", timestamp).Order("block_time, block_num")
+	if before {
+		db = repo.db.Where("block_time <= ?", timestamp).Order("block_time DESC, block_num DESC")
+	}
+	var block *model.Block
+	err := db.Take(&block).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// ListBlocksByNumber lists the stored blocks of the numbers, in block order.
+// Numbers not stored are left out.
+func (repo *repo) ListBlocksByNumber(nums []uint64) ([]*model.Block, error) {
+	var blocks []*model.Block
+	err := repo.db.Where("block_num IN ?", nums).Order("block_num").Find(&blocks).Error
+	if err != nil {
+		return nil, err
+	}
+	return blocks, nil
 }
 
 func (repo *repo) GetTransaction(txHash string) (*model.Transaction, error) {
 	var tx *model.Transaction
-	err := repo.db.Where("tx_hash = ?", txHash).First(&tx).Error
+	err := repo.db.Preload("Receipt").Preload("Receipt.Logs", func(db *gorm.DB) *gorm.DB {
+		return db.Order("log_index")
+	}).Where("tx_hash = ?", txHash).First(&tx).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, ErrNotFound
 	}
@@ -105,13 +286,299 @@ func (repo *repo) GetTransaction(txHash string) (*model.Transaction, error) {
 	return tx, nil
 }
 
+// ListBlockTransactions lists the transactions of the block with their receipts
+// and logs, in block order. Transactions without a stored receipt are left out.
+func (repo *repo) ListBlockTransactions(num uint64) ([]*model.Transaction, error) {
+	var txs []*model.Transaction
+	err := repo.db.Preload("Receipt").Preload("Receipt.Logs", func(db *gorm.DB) *gorm.DB {
+		return db.Order("log_index")
+	}).Select("transactions.*").
+		Joins("JOIN receipts ON receipts.tx_hash = transactions.tx_hash").
+		Where("transactions.block_num = ?", num).
+		Order("receipts.tx_index").Find(&txs).Error
+	if err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+// ListOrphanedTransactions lists the transactions kept for the orphaned block
+// with the hash, ordered by hash as its receipts are not kept.
+func (repo *repo) ListOrphanedTransactions(blockHash string) ([]*model.Transaction, error) {
+	var txs []*model.Transaction
+	err := repo.db.Raw(`SELECT (json_populate_record(NULL::transactions, tx)).* FROM orphaned_transactions
+		WHERE block_hash = ? ORDER BY tx_hash`, blockHash).Scan(&txs).Error
+	if err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+// ListTransactions lists the stored transactions of the hashes with their
+// receipts, in block order. Hashes not stored are left out.
+func (repo *repo) ListTransactions(txHashes []string) ([]*model.Transaction, error) {
+	var txs []*model.Transaction
+	err := repo.db.Preload("Receipt").Select("transactions.*").
+		Joins("LEFT JOIN receipts ON receipts.tx_hash = transactions.tx_hash").
+		Where("transactions.tx_hash IN ?", txHashes).
+		Order("transactions.block_num, receipts.tx_index").Find(&txs).Error
+	if err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
 func (repo *repo) CreateTransaction(tx *model.Transaction) error {
 	return repo.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&tx).Error
 }
 
-func (repo *repo) UpdateTransactionLogs(tx *model.Transaction) error {
-	value, _ := tx.Logs.Value()
-	return repo.db.Model(&tx).Update("logs", value).Error
+func (repo *repo) CreateReceipt(receipt *model.Receipt) error {
+	return repo.db.Session(&gorm.Session{FullSaveAssociations: true}).
+		Clauses(clause.OnConflict{UpdateAll: true}).Create(&receipt).Error
+}
+
+func (repo *repo) UpdateTransaction(tx *model.Transaction) error {
+	return repo.db.Model(&tx).Select("type", "from_addr", "to_addr", "nonce", "gas", "gas_price",
+		"max_fee_per_gas", "max_priority_fee_per_gas", "max_fee_per_blob_gas", "data", "value",
+		"access_list", "blob_hashes", "chain_id", "v", "r", "s").
+		Updates(tx).Error
+}
+
+// ListIncompleteTransactions lists the transactions stored before their input
+// data, gas or signature fields were persisted.
+func (repo *repo) ListIncompleteTransactions(afterTxHash string, limit int) ([]*model.Transaction, error) {
+	var txs []*model.Transaction
+	err := repo.db.Where("(data IS NULL OR gas = 0 OR r IS NULL) AND tx_hash > ?", afterTxHash).
+		Order("tx_hash").Limit(limit).Find(&txs).Error
+	if err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+func (repo *repo) ListAddressTransactions(filter *model.AddressTxFilter) ([]*model.Transaction, error) {
+	db := repo.db.Select("transactions.*").
+		Where("transactions.block_num BETWEEN ? AND ?", *filter.FromBlock, *filter.ToBlock)
+	switch filter.Direction {
+	case model.DirectionIn:
+		db = db.Where("transactions.to_addr = ?", filter.Address)
+	case model.DirectionOut:
+		db = db.Where("transactions.from_addr = ?", filter.Address)
+	default:
+		db = db.Where("(transactions.from_addr = ? OR transactions.to_addr = ?)", filter.Address, filter.Address)
+	}
+
+	if filter.Receipts {
+		db = db.Preload("Receipt")
+	}
+
+	// the listing is ordered by block_num DESC, tx_hash, so a forward page is
+	// read in reverse and flipped back
+	order := "transactions.block_num DESC, transactions.tx_hash"
+	switch filter.Sort {
+	case model.SortAsc:
+		db = db.Joins("LEFT JOIN receipts ON receipts.tx_hash = transactions.tx_hash")
+		order = "transactions.block_num, receipts.tx_index"
+	case model.SortDesc:
+		db = db.Joins("LEFT JOIN receipts ON receipts.tx_hash = transactions.tx_hash")
+		order = "transactions.block_num DESC, receipts.tx_index DESC"
+	}
+	if c := filter.Cursor; c != nil {
+		if c.Forward {
+			db = db.Where("(transactions.block_num > ? OR (transactions.block_num = ? AND transactions.tx_hash < ?))",
+				c.BlockNum, c.BlockNum, c.TxHash)
+			order = "transactions.block_num, transactions.tx_hash DESC"
+		} else {
+			db = db.Where("(transactions.block_num < ? OR (transactions.block_num = ? AND transactions.tx_hash > ?))",
+				c.BlockNum, c.BlockNum, c.TxHash)
+		}
+	}
+
+	var txs []*model.Transaction
+	err := db.Order(order).Limit(filter.Limit).Offset(filter.Offset).Find(&txs).Error
+	if err != nil {
+		return nil, err
+	}
+	if filter.Cursor != nil && filter.Cursor.Forward {
+		for i, j := 0, len(txs)-1; i < j; i, j = i+1, j-1 {
+			txs[i], txs[j] = txs[j], txs[i]
+		}
+	}
+	return txs, nil
+}
+
+func (repo *repo) ListLogs(filter *model.LogFilter) ([]*model.Log, error) {
+	db := repo.db.Where("block_num BETWEEN ? AND ?", *filter.FromBlock, *filter.ToBlock)
+	if len(filter.Addresses) > 0 {
+		db = db.Where("address IN ?", filter.Addresses)
+	}
+	for i, topics := range filter.Topics {
+		if len(topics) > 0 {
+			db = db.Where(fmt.Sprintf("topic%d IN ?", i), topics)
+		}
+	}
+
+	var logs []*model.Log
+	err := db.Order("block_num, log_index").Limit(filter.Limit).Offset(filter.Offset).Find(&logs).Error
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+func (repo *repo) ListInternalTransactions(txHash string) ([]*model.InternalTransaction, error) {
+	var txs []*model.InternalTransaction
+	err := repo.db.Where("tx_hash = ?", txHash).Order("trace_index").Find(&txs).Error
+	if err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+func (repo *repo) ListAddressInternalTransactions(filter *model.InternalTxFilter) ([]*model.InternalTransaction, error) {
+	db := repo.db.Where("block_num BETWEEN ? AND ?", *filter.FromBlock, *filter.ToBlock).
+		Where("(from_addr = ? OR to_addr = ?)", filter.Address, filter.Address)
+
+	order := "block_num, tx_hash, trace_index"
+	if filter.Descending {
+		order = "block_num DESC, tx_hash DESC, trace_index DESC"
+	}
+
+	var txs []*model.InternalTransaction
+	err := db.Order(order).Limit(filter.Limit).Offset(filter.Offset).Find(&txs).Error
+	if err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+func (repo *repo) CreateTokenTransfers(transfers ...*model.TokenTransfer) error {
+	return repo.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&transfers).Error
+}
+
+func (repo *repo) ListTokenTransfers(filter *model.TokenTransferFilter) ([]*model.TokenTransfer, error) {
+	db := repo.db.Where("block_num BETWEEN ? AND ?", *filter.FromBlock, *filter.ToBlock)
+	if filter.Token != "" {
+		db = db.Where("token = ?", filter.Token)
+	}
+	if filter.Address != "" {
+		db = db.Where("(from_addr = ? OR to_addr = ?)", filter.Address, filter.Address)
+	}
+
+	order := "block_num, log_index"
+	if filter.Descending {
+		order = "block_num DESC, log_index DESC"
+	}
+
+	var transfers []*model.TokenTransfer
+	err := db.Order(order).Limit(filter.Limit).Offset(filter.Offset).Find(&transfers).Error
+	if err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (repo *repo) CreateNFTTransfers(transfers ...*model.NFTTransfer) error {
+	return repo.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&transfers).Error
+}
+
+// nftBalances nets the amounts received and sent in nft_transfers per token id
+// and owner. Mints come from and burns go to the zero address, which is not an
+// owner.
+const nftBalances = `
+	SELECT token, token_id, owner, SUM(amount)::TEXT AS amount FROM (
+		SELECT token, token_id, to_addr AS owner, amount::NUMERIC AS amount FROM nft_transfers
+		WHERE %[1]s
+		UNION ALL
+		SELECT token, token_id, from_addr AS owner, -amount::NUMERIC AS amount FROM nft_transfers
+		WHERE %[1]s
+	) t
+	WHERE owner <> '0x0000000000000000000000000000000000000000'
+	GROUP BY token, token_id, owner
+	HAVING SUM(amount) > 0`
+
+func (repo *repo) ListNFTOwners(token, tokenID string) ([]*model.NFTHolding, error) {
+	var holdings []*model.NFTHolding
+	sql := fmt.Sprintf(nftBalances, "token = @token AND token_id = @token_id") + ` ORDER BY owner`
+	err := repo.db.Raw(sql, map[string]interface{}{"token": token, "token_id": tokenID}).Scan(&holdings).Error
+	if err != nil {
+		return nil, err
+	}
+	return holdings, nil
+}
+
+func (repo *repo) ListNFTInventory(owner string, limit int) ([]*model.NFTHolding, error) {
+	var holdings []*model.NFTHolding
+	sql := fmt.Sprintf(nftBalances, "(from_addr = @owner OR to_addr = @owner)") +
+		` AND owner = @owner ORDER BY token, token_id LIMIT @limit`
+	err := repo.db.Raw(sql, map[string]interface{}{"owner": owner, "limit": limit}).Scan(&holdings).Error
+	if err != nil {
+		return nil, err
+	}
+	return holdings, nil
+}
+
+func (repo *repo) SetContractABI(address, abi string) error {
+	contractABI := &model.ContractABI{Address: address, ABI: abi, UpdatedAt: time.Now()}
+	return repo.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&contractABI).Error
+}
+
+func (repo *repo) ListContractABIs(addresses ...string) ([]*model.ContractABI, error) {
+	var contractABIs []*model.ContractABI
+	err := repo.db.Where("address IN ?", addresses).Find(&contractABIs).Error
+	if err != nil {
+		return nil, err
+	}
+	return contractABIs, nil
+}
+
+func (repo *repo) CreateSignatures(signatures ...*model.Signature) error {
+	return repo.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&signatures).Error
+}
+
+// ListSignatures lists the signatures of the function selectors and event
+// topics.
+func (repo *repo) ListSignatures(selectors, topics []string) ([]*model.Signature, error) {
+	var signatures []*model.Signature
+	err := repo.db.Where("selector IN ? OR topic IN ?", selectors, topics).
+		Order("signature").Find(&signatures).Error
+	if err != nil {
+		return nil, err
+	}
+	return signatures, nil
+}
+
+func (repo *repo) GetCheckpoint(name string) (*model.Checkpoint, error) {
+	var checkpoint *model.Checkpoint
+	err := repo.db.Where("name = ?", name).First(&checkpoint).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+func (repo *repo) SetCheckpoint(name string, num uint64) error {
+	checkpoint := &model.Checkpoint{Name: name, BlockNum: num, UpdatedAt: time.Now()}
+	return repo.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&checkpoint).Error
+}
+
+// UpdateFinality marks the blocks up to finalizedNum as finalized and the
+// ones up to safeNum as safe.
+func (repo *repo) UpdateFinality(safeNum, finalizedNum uint64) error {
+	return repo.db.Transaction(func(db *gorm.DB) error {
+		err := db.Model(&model.Block{}).
+			Where("block_num <= ? AND finality <> ?", finalizedNum, model.FinalityFinalized).
+			Update("finality", model.FinalityFinalized).Error
+		if err != nil {
+			return err
+		}
+		return db.Model(&model.Block{}).
+			Where("block_num <= ? AND finality = ?", safeNum, model.FinalityLatest).
+			Update("finality", model.FinalitySafe).Error
+	})
 }
 
 func (repo *repo) GetBlockNumber(ctx context.Context) (uint64, error) {
@@ -184,16 +651,21 @@ func (repo *repo) SetBlockCache(ctx context.Context, blocks ...*model.Block) err
 
 func (repo *repo) DelBlockCache(ctx context.Context, blocks ...*model.Block) error {
 	delKeys := make([]string, len(blocks))
-	zmembers := make([]interface{}, len(blocks))
 	for i, block := range blocks {
 		delKeys[i] = fmt.Sprintf("%s%d", blockCacheKeyPrefix, block.BlockNum)
-		zmembers[i] = block
 	}
 	err := repo.redis.Del(ctx, delKeys...).Err()
 	if err != nil {
 		return err
 	}
-	return repo.redis.ZRem(ctx, blockListCacheKey, zmembers...).Err()
+	// remove by score, the cached member may differ from the given block
+	pipe := repo.redis.Pipeline()
+	for _, block := range blocks {
+		num := fmt.Sprint(block.BlockNum)
+		pipe.ZRemRangeByScore(ctx, blockListCacheKey, num, num)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
 }
 
 func (repo *repo) LockBlock(ctx context.Context, num uint64) (bool, error) {
@@ -232,6 +704,14 @@ func (repo *repo) SetTxCache(ctx context.Context, txHash string, tx *model.Trans
 	return repo.redis.Set(ctx, key, tx, txCacheTTL).Err()
 }
 
+func (repo *repo) DelTxCache(ctx context.Context, txHashes ...string) error {
+	delKeys := make([]string, len(txHashes))
+	for i, txHash := range txHashes {
+		delKeys[i] = fmt.Sprintf("%s%s", txCacheKeyPrefix, txHash)
+	}
+	return repo.redis.Del(ctx, delKeys...).Err()
+}
+
 func (repo *repo) LockTransaction(ctx context.Context, txHash string) (bool, error) {
 	key := fmt.Sprintf("%s%s", txLockKeyPrefix, txHash)
 	getLock, err := repo.redis.SetNX(ctx, key, true, blockLockTTL).Result()
@@ -0,0 +1,348 @@
+package repo
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"Kumazan/go-ethereum-server/pkg/model"
+)
+
+// newTestRepo returns a repo on an in-memory database holding txs, each with a
+// receipt at its position in the block. The redis cache is left out, so only
+// the database queries can be tested.
+func newTestRepo(t *testing.T, txs ...*model.Transaction) *repo {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// every connection would open a database of its own
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	err = db.AutoMigrate(&model.Block{}, &model.Transaction{}, &model.Receipt{}, &model.Log{},
+		&model.TokenTransfer{}, &model.NFTTransfer{}, &model.InternalTransaction{}, &model.Checkpoint{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sql := range []string{
+		`CREATE TABLE orphaned_blocks (block_hash TEXT PRIMARY KEY, block_num INTEGER NOT NULL,
+			block_time INTEGER NOT NULL, parent_hash TEXT NOT NULL)`,
+		`CREATE TABLE orphaned_transactions (tx_hash TEXT NOT NULL, block_hash TEXT NOT NULL,
+			block_num INTEGER NOT NULL, tx TEXT NOT NULL, PRIMARY KEY (tx_hash, block_hash))`,
+	} {
+		if err := db.Exec(sql).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.Callback().Raw().Before("gorm:raw").Register("test:row_to_json", rowToJSON); err != nil {
+		t.Fatal(err)
+	}
+	for _, tx := range txs {
+		if err := db.Create(tx).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &repo{db: db}
+}
+
+// rowToJSON rewrites the row_to_json(t) of Postgres, which SQLite lacks, to
+// a JSON object holding the hash of the transaction aliased t. The tests do
+// not read the orphaned transactions back from it.
+func rowToJSON(db *gorm.DB) {
+	sql := db.Statement.SQL.String()
+	if !strings.Contains(sql, "row_to_json(t)") {
+		return
+	}
+	db.Statement.SQL.Reset()
+	db.Statement.SQL.WriteString(strings.Replace(sql, "row_to_json(t)", `'{"tx_hash":"' || t.tx_hash || '"}'`, 1))
+}
+
+// newTestTx returns a transaction from the address at the index of the
+// block.
+func newTestTx(hash string, num uint64, index uint) *model.Transaction {
+	return &model.Transaction{
+		TxHash:   hash,
+		BlockNum: num,
+		FromAddr: "0x01",
+		ToAddr:   "0x02",
+		Receipt:  &model.Receipt{TxHash: hash, BlockNum: num, TxIndex: index},
+	}
+}
+
+func txHashes(txs []*model.Transaction) []string {
+	hashes := []string{}
+	for _, tx := range txs {
+		hashes = append(hashes, tx.TxHash)
+	}
+	return hashes
+}
+
+func TestListAddressTransactionsCursor(t *testing.T) {
+	// listed newest first by block and then hash: 0xe, 0xd, 0xb, 0xc, 0xa
+	r := newTestRepo(t,
+		newTestTx("0xa", 1, 0),
+		newTestTx("0xb", 2, 1),
+		newTestTx("0xc", 2, 0),
+		newTestTx("0xd", 3, 0),
+		newTestTx("0xe", 4, 0),
+	)
+	tests := []struct {
+		name   string
+		cursor *model.Cursor
+		want   []string
+	}{
+		{"first page", nil, []string{"0xe", "0xd"}},
+		{"page back", &model.Cursor{BlockNum: 3, TxHash: "0xd"}, []string{"0xb", "0xc"}},
+		{"page back within a block", &model.Cursor{BlockNum: 2, TxHash: "0xb"}, []string{"0xc", "0xa"}},
+		{"last page back", &model.Cursor{BlockNum: 2, TxHash: "0xc"}, []string{"0xa"}},
+		{"past the oldest", &model.Cursor{BlockNum: 1, TxHash: "0xa"}, []string{}},
+		{"page forward", &model.Cursor{BlockNum: 2, TxHash: "0xc", Forward: true}, []string{"0xd", "0xb"}},
+		{"page forward within a block", &model.Cursor{BlockNum: 1, TxHash: "0xa", Forward: true}, []string{"0xb", "0xc"}},
+		{"last page forward", &model.Cursor{BlockNum: 3, TxHash: "0xd", Forward: true}, []string{"0xe"}},
+		{"past the newest", &model.Cursor{BlockNum: 4, TxHash: "0xe", Forward: true}, []string{}},
+	}
+	from, to := uint64(0), uint64(4)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txs, err := r.ListAddressTransactions(&model.AddressTxFilter{
+				Address:   "0x01",
+				Direction: model.DirectionOut,
+				FromBlock: &from,
+				ToBlock:   &to,
+				Cursor:    tt.cursor,
+				Limit:     2,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := txHashes(txs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListAddressTransactions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListAddressTransactionsRange(t *testing.T) {
+	r := newTestRepo(t,
+		newTestTx("0xa", 1, 0),
+		newTestTx("0xb", 2, 0),
+		newTestTx("0xc", 3, 0),
+	)
+	tests := []struct {
+		name     string
+		from, to uint64
+		address  string
+		want     []string
+	}{
+		{"whole range", 1, 3, "0x01", []string{"0xc", "0xb", "0xa"}},
+		{"one block", 2, 2, "0x01", []string{"0xb"}},
+		{"empty range", 4, 9, "0x01", []string{}},
+		{"recipient", 1, 3, "0x02", []string{"0xc", "0xb", "0xa"}},
+		{"other address", 1, 3, "0x03", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txs, err := r.ListAddressTransactions(&model.AddressTxFilter{
+				Address:   tt.address,
+				Direction: model.DirectionBoth,
+				FromBlock: &tt.from,
+				ToBlock:   &tt.to,
+				Limit:     10,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := txHashes(txs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListAddressTransactions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListAddressTransactionsSort(t *testing.T) {
+	// 0xb comes before 0xa in block 2, against the order of their hashes
+	r := newTestRepo(t,
+		newTestTx("0xc", 1, 0),
+		newTestTx("0xa", 2, 1),
+		newTestTx("0xb", 2, 0),
+		newTestTx("0xd", 3, 0),
+	)
+	tests := []struct {
+		sort   string
+		offset int
+		want   []string
+	}{
+		{model.SortAsc, 0, []string{"0xc", "0xb", "0xa"}},
+		{model.SortAsc, 2, []string{"0xa", "0xd"}},
+		{model.SortDesc, 0, []string{"0xd", "0xa", "0xb"}},
+		{model.SortDesc, 3, []string{"0xc"}},
+		{model.SortDesc, 4, []string{}},
+	}
+	from, to := uint64(0), uint64(3)
+	for _, tt := range tests {
+		txs, err := r.ListAddressTransactions(&model.AddressTxFilter{
+			Address:   "0x01",
+			Direction: model.DirectionBoth,
+			FromBlock: &from,
+			ToBlock:   &to,
+			Limit:     3,
+			Offset:    tt.offset,
+			Sort:      tt.sort,
+			Receipts:  true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := txHashes(txs); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("sort %s from %d = %v, want %v", tt.sort, tt.offset, got, tt.want)
+		}
+		for _, tx := range txs {
+			if tx.Receipt == nil || tx.Receipt.TxHash != tx.TxHash {
+				t.Errorf("transaction %s has receipt %+v", tx.TxHash, tx.Receipt)
+			}
+		}
+	}
+}
+
+func TestListTransactions(t *testing.T) {
+	r := newTestRepo(t,
+		newTestTx("0xc", 1, 0),
+		newTestTx("0xa", 2, 1),
+		newTestTx("0xb", 2, 0),
+	)
+	txs, err := r.ListTransactions([]string{"0xa", "0xb", "0xc", "0xd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := txHashes(txs), []string{"0xc", "0xb", "0xa"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListTransactions = %v, want %v", got, want)
+	}
+}
+
+// newTestBlock returns the block with a transaction of the hash, whose receipt
+// holds a log and a token transfer.
+func newTestBlock(num uint64, hash, txHash string) *model.Block {
+	return &model.Block{
+		BlockNum:  num,
+		BlockHash: hash,
+		Transactions: []*model.Transaction{{
+			TxHash:   txHash,
+			BlockNum: num,
+			FromAddr: "0x01",
+			ToAddr:   "0x02",
+			Receipt: &model.Receipt{
+				TxHash:         txHash,
+				BlockNum:       num,
+				BlockHash:      hash,
+				Logs:           []model.Log{{TxHash: txHash, BlockNum: num, BlockHash: hash, Address: "0x03"}},
+				TokenTransfers: []model.TokenTransfer{{TxHash: txHash, BlockNum: num, Token: "0x03"}},
+			},
+		}},
+	}
+}
+
+func TestCreateBlocksReorg(t *testing.T) {
+	r := newTestRepo(t)
+	orphaned, err := r.CreateBlocks(newTestBlock(1, "0x1a", "0xa1"), newTestBlock(2, "0x2a", "0xa2"), newTestBlock(3, "0x3a", "0xa3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orphaned) != 0 {
+		t.Fatalf("first blocks orphaned %d blocks, want none", len(orphaned))
+	}
+	// storing a block again is not a reorg
+	if orphaned, err = r.CreateBlocks(newTestBlock(3, "0x3a", "0xa3")); err != nil || len(orphaned) != 0 {
+		t.Fatalf("same block orphaned %d blocks, %v, want none", len(orphaned), err)
+	}
+
+	// the chain forks at block 2
+	orphaned, err = r.CreateBlocks(newTestBlock(2, "0x2b", "0xb2"), newTestBlock(3, "0x3b", "0xb3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, b := range orphaned {
+		if !b.Orphaned || len(b.Transactions) != 1 || b.Transactions[0].Receipt == nil || len(b.Transactions[0].Receipt.Logs) != 1 {
+			t.Errorf("orphaned block %s = %+v, want it with its transaction, receipt and log", b.BlockHash, b)
+		}
+		got = append(got, b.BlockHash+":"+strings.Join(b.TxHash, ","))
+	}
+	if want := []string{"0x2a:0xa2", "0x3a:0xa3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("orphaned blocks = %v, want %v", got, want)
+	}
+
+	for _, check := range []struct {
+		table  string
+		column string
+		want   []string
+	}{
+		{"blocks", "block_hash", []string{"0x1a", "0x2b", "0x3b"}},
+		{"transactions", "tx_hash", []string{"0xa1", "0xb2", "0xb3"}},
+		{"receipts", "tx_hash", []string{"0xa1", "0xb2", "0xb3"}},
+		{"logs", "tx_hash", []string{"0xa1", "0xb2", "0xb3"}},
+		{"token_transfers", "tx_hash", []string{"0xa1", "0xb2", "0xb3"}},
+		{"orphaned_blocks", "block_hash", []string{"0x2a", "0x3a"}},
+		{"orphaned_transactions", "block_hash || ':' || tx_hash", []string{"0x2a:0xa2", "0x3a:0xa3"}},
+	} {
+		var values []string
+		if err := r.db.Table(check.table).Order(check.column).Pluck(check.column, &values).Error; err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(values, check.want) {
+			t.Errorf("%s %s = %v, want %v", check.table, check.column, values, check.want)
+		}
+	}
+
+	block, err := r.GetBlockByHash("0x2a", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !block.Orphaned || block.BlockNum != 2 || !reflect.DeepEqual(block.TxHash, []string{"0xa2"}) {
+		t.Errorf("orphaned block = %+v, want block 2 with transaction 0xa2", block)
+	}
+	if _, err := r.GetBlockByHash("0x2a", false); err != ErrNotFound {
+		t.Errorf("GetBlockByHash of an orphaned block = %v, want ErrNotFound", err)
+	}
+}
+
+func TestUpdateFinality(t *testing.T) {
+	r := newTestRepo(t)
+	var blocks []*model.Block
+	for num := uint64(1); num <= 6; num++ {
+		hash := "0x" + strconv.FormatUint(num, 16)
+		block := newTestBlock(num, hash, hash+"0")
+		block.Finality = model.FinalityLatest
+		blocks = append(blocks, block)
+	}
+	if _, err := r.CreateBlocks(blocks...); err != nil {
+		t.Fatal(err)
+	}
+
+	const latest, safe, finalized = model.FinalityLatest, model.FinalitySafe, model.FinalityFinalized
+	for _, step := range []struct {
+		safeNum, finalizedNum uint64
+		want                  []string
+	}{
+		{4, 2, []string{finalized, finalized, safe, safe, latest, latest}},
+		// the heads move on, a safe block becomes finalized
+		{5, 4, []string{finalized, finalized, finalized, finalized, safe, latest}},
+	} {
+		if err := r.UpdateFinality(step.safeNum, step.finalizedNum); err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		if err := r.db.Model(&model.Block{}).Order("block_num").Pluck("finality", &got).Error; err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, step.want) {
+			t.Errorf("UpdateFinality(%d, %d) finality = %v, want %v", step.safeNum, step.finalizedNum, got, step.want)
+		}
+	}
+}
@@ -0,0 +1,808 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"Kumazan/go-ethereum-server/pb"
+	"Kumazan/go-ethereum-server/pkg/model"
+)
+
+const (
+	// a listing may reach this many results, whatever its page and offset
+	maxEtherscanResults = 10000
+	maxEtherscanLogs    = 1000
+
+	// tokens whose metadata is read in one upstream batch, three calls each
+	maxTokenBatch = 100
+)
+
+// selectors of the ERC-20 metadata methods
+const (
+	nameSelector     = "0x06fdde03"
+	symbolSelector   = "0x95d89b41"
+	decimalsSelector = "0x313ce567"
+)
+
+// etherscanError is answered as a failed call, with the message as result.
+type etherscanError string
+
+func (e etherscanError) Error() string {
+	return string(e)
+}
+
+// etherscanEmpty is answered for a listing without results, which Etherscan
+// also reports with status "0".
+type etherscanEmpty string
+
+func (e etherscanEmpty) Error() string {
+	return string(e)
+}
+
+var (
+	errNoTransactions = etherscanEmpty("No transactions found")
+	errNoRecords      = etherscanEmpty("No records found")
+)
+
+// serveEtherscan answers the account, block and logs calls of the Etherscan
+// API from the index, with the same envelope and paging parameters, so tools
+// written against Etherscan only need a new base URL.
+func (h *Handler) serveEtherscan(c *gin.Context) {
+	var action func(*gin.Context) (interface{}, error)
+	switch module := c.Query("module"); {
+	case module == "account" && c.Query("action") == "txlist":
+		action = h.etherscanTxList
+	case module == "account" && c.Query("action") == "tokentx":
+		action = h.etherscanTokenTx
+	case module == "account" && c.Query("action") == "txlistinternal":
+		action = h.etherscanInternalTxList
+	case module == "block" && c.Query("action") == "getblocknobytime":
+		action = h.etherscanBlockNumberByTime
+	case module == "logs" && c.Query("action") == "getLogs":
+		action = h.etherscanLogs
+	case module == "account" || module == "block" || module == "logs":
+		action = func(*gin.Context) (interface{}, error) {
+			return nil, etherscanError("Error! Missing Or invalid Action name")
+		}
+	default:
+		action = func(*gin.Context) (interface{}, error) {
+			return nil, etherscanError("Error! Missing Or invalid Module name")
+		}
+	}
+
+	result, err := action(c)
+	var empty etherscanEmpty
+	var failed etherscanError
+	switch {
+	case errors.As(err, &empty):
+		c.JSON(http.StatusOK, gin.H{"status": "0", "message": string(empty), "result": []interface{}{}})
+	case errors.As(err, &failed):
+		c.JSON(http.StatusOK, gin.H{"status": "0", "message": "NOTOK", "result": string(failed)})
+	case err != nil:
+		// Etherscan clients parse the envelope whatever went wrong
+		c.JSON(http.StatusOK, gin.H{"status": "0", "message": "NOTOK", "result": "Error! Internal error"})
+	default:
+		c.JSON(http.StatusOK, gin.H{"status": "1", "message": "OK", "result": result})
+	}
+}
+
+// etherscanPage is the window of a listing selected by the page, offset and
+// sort parameters.
+type etherscanPage struct {
+	limit  int
+	offset int
+	desc   bool
+}
+
+// parseEtherscanPage parses the page and offset, the page size, of a listing.
+// Missing or zero, they select the first maxResults results.
+func parseEtherscanPage(c *gin.Context, maxResults int) (*etherscanPage, error) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "0"))
+	if err != nil || page < 0 {
+		return nil, etherscanError("Error! Invalid page number")
+	}
+	size, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || size < 0 {
+		return nil, etherscanError("Error! Invalid offset")
+	}
+	if page == 0 {
+		page = 1
+	}
+	if size == 0 {
+		size = maxResults
+	}
+	if page > maxResults/size {
+		return nil, etherscanError(fmt.Sprintf("Result window is too large, PageNo x Offset size must be less than or equal to %d", maxResults))
+	}
+
+	p := &etherscanPage{limit: size, offset: (page - 1) * size}
+	switch c.DefaultQuery("sort", "asc") {
+	case "asc":
+	case "desc":
+		p.desc = true
+	default:
+		return nil, etherscanError("Error! Invalid sort order")
+	}
+	return p, nil
+}
+
+// parseEtherscanBlocks parses a block range, from the first block to the
+// latest one by default, which is left unset.
+func parseEtherscanBlocks(c *gin.Context, fromKey, toKey string) (*wrapperspb.UInt64Value, *wrapperspb.UInt64Value, error) {
+	fromBlock, ok := parseBlockTag(c.DefaultQuery(fromKey, "0"))
+	if !ok {
+		return nil, nil, etherscanError("Error! Invalid " + fromKey)
+	}
+	toBlock, ok := parseBlockTag(c.DefaultQuery(toKey, "latest"))
+	if !ok {
+		return nil, nil, etherscanError("Error! Invalid " + toKey)
+	}
+	return blockValue(fromBlock), blockValue(toBlock), nil
+}
+
+// parseEtherscanAddress returns the address of the parameter checksummed as it
+// is indexed, or an empty string when it is missing.
+func parseEtherscanAddress(c *gin.Context, key string) (string, error) {
+	address := c.Query(key)
+	if address == "" {
+		return "", nil
+	}
+	if !addressValidator.MatchString(address) {
+		return "", etherscanError("Error! Invalid address format")
+	}
+	return common.HexToAddress(address).String(), nil
+}
+
+func (h *Handler) etherscanTxList(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	address, err := parseEtherscanAddress(c, "address")
+	if err != nil {
+		return nil, err
+	}
+	if address == "" {
+		return nil, etherscanError("Error! Invalid address format")
+	}
+	page, err := parseEtherscanPage(c, maxEtherscanResults)
+	if err != nil {
+		return nil, err
+	}
+	fromBlock, toBlock, err := parseEtherscanBlocks(c, "startblock", "endblock")
+	if err != nil {
+		return nil, err
+	}
+
+	sort := model.SortAsc
+	if page.desc {
+		sort = model.SortDesc
+	}
+	resp, err := h.ec.GetAddressTransactions(ctx, &pb.GetAddressTransactionsRequest{
+		Address:   address,
+		Direction: model.DirectionBoth,
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Limit:     int32(page.limit),
+		Offset:    int32(page.offset),
+		Sort:      sort,
+		Receipts:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Txs) == 0 {
+		return nil, errNoTransactions
+	}
+
+	nums := make([]int64, len(resp.Txs))
+	for i, tx := range resp.Txs {
+		nums[i] = tx.BlockNum
+	}
+	blocks, head, err := h.etherscanBlocks(ctx, nums)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]etherscanTx, len(resp.Txs))
+	for i, tx := range resp.Txs {
+		txs[i] = newEtherscanTx(tx, blocks[tx.BlockNum], head)
+	}
+	return txs, nil
+}
+
+func (h *Handler) etherscanTokenTx(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	address, err := parseEtherscanAddress(c, "address")
+	if err != nil {
+		return nil, err
+	}
+	token, err := parseEtherscanAddress(c, "contractaddress")
+	if err != nil {
+		return nil, err
+	}
+	if address == "" && token == "" {
+		return nil, etherscanError("Error! Missing address or contractaddress")
+	}
+	page, err := parseEtherscanPage(c, maxEtherscanResults)
+	if err != nil {
+		return nil, err
+	}
+	fromBlock, toBlock, err := parseEtherscanBlocks(c, "startblock", "endblock")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.ec.GetTokenTransfers(ctx, &pb.GetTokenTransfersRequest{
+		Token:      token,
+		Address:    address,
+		FromBlock:  fromBlock,
+		ToBlock:    toBlock,
+		Limit:      int32(page.limit),
+		Offset:     int32(page.offset),
+		Descending: page.desc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Transfers) == 0 {
+		return nil, errNoTransactions
+	}
+
+	nums := make([]int64, len(resp.Transfers))
+	hashes := make([]string, len(resp.Transfers))
+	tokens := make([]string, len(resp.Transfers))
+	for i, transfer := range resp.Transfers {
+		nums[i] = transfer.BlockNum
+		hashes[i] = transfer.TxHash
+		tokens[i] = transfer.Token
+	}
+	blocks, head, err := h.etherscanBlocks(ctx, nums)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := h.etherscanTransactions(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+	metadata := h.tokenMetadata(ctx, tokens)
+
+	transfers := make([]etherscanTokenTx, len(resp.Transfers))
+	for i, transfer := range resp.Transfers {
+		transfers[i] = newEtherscanTokenTx(transfer, txs[transfer.TxHash], blocks[transfer.BlockNum], head, metadata[transfer.Token])
+	}
+	return transfers, nil
+}
+
+func (h *Handler) etherscanInternalTxList(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	address, err := parseEtherscanAddress(c, "address")
+	if err != nil {
+		return nil, err
+	}
+	txHash := c.Query("txhash")
+	if txHash != "" && !hashValidator.MatchString(txHash) {
+		return nil, etherscanError("Error! Invalid txhash format")
+	}
+	if (address == "") == (txHash == "") {
+		return nil, etherscanError("Error! Missing Or invalid address or txhash")
+	}
+	page, err := parseEtherscanPage(c, maxEtherscanResults)
+	if err != nil {
+		return nil, err
+	}
+
+	var internalTxs []*pb.InternalTransaction
+	if txHash != "" {
+		// the calls of one transaction are few, so they are paged here
+		resp, err := h.ec.GetInternalTransactions(ctx, &pb.GetInternalTransactionsRequest{TxHash: common.HexToHash(txHash).String()})
+		if isNotFound(err) {
+			return nil, errNoTransactions
+		}
+		if err != nil {
+			return nil, err
+		}
+		internalTxs = resp.InternalTransactions
+		if page.desc {
+			for i, j := 0, len(internalTxs)-1; i < j; i, j = i+1, j-1 {
+				internalTxs[i], internalTxs[j] = internalTxs[j], internalTxs[i]
+			}
+		}
+		internalTxs = internalTxs[min(page.offset, len(internalTxs)):min(page.offset+page.limit, len(internalTxs))]
+	} else {
+		fromBlock, toBlock, err := parseEtherscanBlocks(c, "startblock", "endblock")
+		if err != nil {
+			return nil, err
+		}
+		resp, err := h.ec.GetAddressInternalTransactions(ctx, &pb.GetAddressInternalTransactionsRequest{
+			Address:    address,
+			FromBlock:  fromBlock,
+			ToBlock:    toBlock,
+			Limit:      int32(page.limit),
+			Offset:     int32(page.offset),
+			Descending: page.desc,
+		})
+		if err != nil {
+			return nil, err
+		}
+		internalTxs = resp.InternalTransactions
+	}
+	if len(internalTxs) == 0 {
+		return nil, errNoTransactions
+	}
+
+	nums := make([]int64, len(internalTxs))
+	for i, tx := range internalTxs {
+		nums[i] = tx.BlockNum
+	}
+	blocks, _, err := h.etherscanBlocks(ctx, nums)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]etherscanInternalTx, len(internalTxs))
+	for i, tx := range internalTxs {
+		txs[i] = newEtherscanInternalTx(tx, blocks[tx.BlockNum])
+	}
+	return txs, nil
+}
+
+func (h *Handler) etherscanBlockNumberByTime(c *gin.Context) (interface{}, error) {
+	timestamp, err := strconv.ParseInt(c.Query("timestamp"), 10, 64)
+	if err != nil || timestamp < 0 {
+		return nil, etherscanError("Error! Invalid timestamp")
+	}
+	closest := c.Query("closest")
+	if closest != "before" && closest != "after" {
+		return nil, etherscanError("Error! Invalid closest parameter")
+	}
+
+	resp, err := h.ec.GetBlockByTime(c.Request.Context(), &pb.GetBlockByTimeRequest{Timestamp: timestamp, Closest: closest})
+	if isNotFound(err) {
+		return nil, etherscanError("Error! No closest block found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return strconv.FormatInt(resp.Block.BlockNum, 10), nil
+}
+
+// etherscanLogs lists the logs of an address and topics. Topics of different
+// positions must all match, as Etherscan's "and" operator; the "or" operator
+// is not supported.
+func (h *Handler) etherscanLogs(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	address, err := parseEtherscanAddress(c, "address")
+	if err != nil {
+		return nil, err
+	}
+	page, err := parseEtherscanPage(c, maxEtherscanLogs)
+	if err != nil {
+		return nil, err
+	}
+	fromBlock, toBlock, err := parseEtherscanBlocks(c, "fromBlock", "toBlock")
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.GetLogsRequest{
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Limit:     int32(page.limit),
+		Offset:    int32(page.offset),
+	}
+	if address != "" {
+		req.Addresses = []string{address}
+	}
+	for i := 0; i < 4; i++ {
+		topic := c.Query(fmt.Sprintf("topic%d", i))
+		if topic == "" {
+			continue
+		}
+		if !hashValidator.MatchString(topic) {
+			return nil, etherscanError(fmt.Sprintf("Error! Invalid topic%d format", i))
+		}
+		for len(req.Topics) <= i {
+			req.Topics = append(req.Topics, &pb.TopicFilter{})
+		}
+		req.Topics[i].Topics = []string{strings.ToLower(topic)}
+	}
+	for i := 0; i < 4; i++ {
+		for j := i + 1; j < 4; j++ {
+			switch c.DefaultQuery(fmt.Sprintf("topic%d_%d_opr", i, j), "and") {
+			case "and":
+			case "or":
+				return nil, etherscanError("Error! Only the and topic operator is supported")
+			default:
+				return nil, etherscanError(fmt.Sprintf("Error! Invalid topic%d_%d_opr", i, j))
+			}
+		}
+	}
+
+	resp, err := h.ec.GetLogs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Logs) == 0 {
+		return nil, errNoRecords
+	}
+
+	nums := make([]int64, len(resp.Logs))
+	hashes := make([]string, len(resp.Logs))
+	for i, log := range resp.Logs {
+		nums[i] = log.BlockNum
+		hashes[i] = log.TxHash
+	}
+	blocks, _, err := h.etherscanBlocks(ctx, nums)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := h.etherscanTransactions(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]etherscanLog, len(resp.Logs))
+	for i, log := range resp.Logs {
+		logs[i] = newEtherscanLog(log, txs[log.TxHash], blocks[log.BlockNum])
+	}
+	return logs, nil
+}
+
+// etherscanBlocks looks up the blocks of the numbers, for their hashes and
+// timestamps, and the latest block, for the confirmations.
+func (h *Handler) etherscanBlocks(ctx context.Context, nums []int64) (map[int64]*pb.Block, uint64, error) {
+	head, err := headNumber(ctx, h.ec, model.FinalityLatest)
+	if err != nil {
+		return nil, 0, err
+	}
+	seen := make(map[int64]bool, len(nums))
+	var unique []int64
+	for _, num := range nums {
+		if !seen[num] {
+			seen[num] = true
+			unique = append(unique, num)
+		}
+	}
+	resp, err := h.ec.ListBlocksByNumber(ctx, &pb.ListBlocksByNumberRequest{BlockNums: unique})
+	if err != nil {
+		return nil, 0, err
+	}
+	blocks := make(map[int64]*pb.Block, len(resp.Blocks))
+	for _, block := range resp.Blocks {
+		blocks[block.BlockNum] = block
+	}
+	return blocks, head, nil
+}
+
+// etherscanTransactions looks up the transactions of the hashes with their
+// receipts.
+func (h *Handler) etherscanTransactions(ctx context.Context, hashes []string) (map[string]*pb.Transaction, error) {
+	seen := make(map[string]bool, len(hashes))
+	var unique []string
+	for _, hash := range hashes {
+		if !seen[hash] {
+			seen[hash] = true
+			unique = append(unique, hash)
+		}
+	}
+	resp, err := h.ec.ListTransactions(ctx, &pb.ListTransactionsRequest{TxHashes: unique})
+	if err != nil {
+		return nil, err
+	}
+	txs := make(map[string]*pb.Transaction, len(resp.Txs))
+	for _, tx := range resp.Txs {
+		txs[tx.TxHash] = tx
+	}
+	return txs, nil
+}
+
+// tokenInfo is the name, symbol and decimals of an ERC-20 token, empty when
+// the token does not implement them.
+type tokenInfo struct {
+	name     string
+	symbol   string
+	decimals string
+}
+
+// tokenMetadata reads the metadata of the tokens from the upstream endpoints,
+// as the index does not hold it. It is left empty when no upstream is
+// configured or the calls fail.
+func (h *Handler) tokenMetadata(ctx context.Context, tokens []string) map[string]*tokenInfo {
+	metadata := make(map[string]*tokenInfo)
+	var unique []string
+	for _, token := range tokens {
+		if metadata[token] == nil {
+			metadata[token] = &tokenInfo{}
+			unique = append(unique, token)
+		}
+	}
+	if h.upstream == nil {
+		return metadata
+	}
+
+	selectors := []string{nameSelector, symbolSelector, decimalsSelector}
+	for start := 0; start < len(unique); start += maxTokenBatch {
+		chunk := unique[start:min(start+maxTokenBatch, len(unique))]
+		results := make([]hexutil.Bytes, len(chunk)*len(selectors))
+		batch := make([]rpc.BatchElem, len(results))
+		for i, token := range chunk {
+			for j, selector := range selectors {
+				k := i*len(selectors) + j
+				batch[k] = rpc.BatchElem{
+					Method: "eth_call",
+					Args:   []interface{}{map[string]string{"to": token, "data": selector}, "latest"},
+					Result: &results[k],
+				}
+			}
+		}
+		if err := h.upstream.BatchCallContext(ctx, batch); err != nil {
+			return metadata
+		}
+
+		for i, token := range chunk {
+			k := i * len(selectors)
+			info := metadata[token]
+			if batch[k].Error == nil {
+				info.name = decodeABIString(results[k])
+			}
+			if batch[k+1].Error == nil {
+				info.symbol = decodeABIString(results[k+1])
+			}
+			if batch[k+2].Error == nil && len(results[k+2]) == common.HashLength {
+				info.decimals = new(big.Int).SetBytes(results[k+2]).String()
+			}
+		}
+	}
+	return metadata
+}
+
+// decodeABIString decodes a string returned by a call, or a bytes32 as
+// returned by the metadata methods of some early tokens.
+func decodeABIString(data []byte) string {
+	if len(data) == common.HashLength {
+		return string(bytes.TrimRight(data, "\x00"))
+	}
+	if len(data) < 2*common.HashLength {
+		return ""
+	}
+	offset := new(big.Int).SetBytes(data[:common.HashLength])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(data)-common.HashLength) {
+		return ""
+	}
+	start := offset.Uint64() + common.HashLength
+	length := new(big.Int).SetBytes(data[start-common.HashLength : start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(data))-start {
+		return ""
+	}
+	return strings.ToValidUTF8(string(data[start:start+length.Uint64()]), "")
+}
+
+// etherscanTx is a transaction as listed by txlist. Numbers are decimal and
+// addresses lowercase, as Etherscan returns them.
+type etherscanTx struct {
+	BlockNumber       string `json:"blockNumber"`
+	TimeStamp         string `json:"timeStamp"`
+	Hash              string `json:"hash"`
+	Nonce             string `json:"nonce"`
+	BlockHash         string `json:"blockHash"`
+	TransactionIndex  string `json:"transactionIndex"`
+	From              string `json:"from"`
+	To                string `json:"to"`
+	Value             string `json:"value"`
+	Gas               string `json:"gas"`
+	GasPrice          string `json:"gasPrice"`
+	IsError           string `json:"isError"`
+	TxReceiptStatus   string `json:"txreceipt_status"`
+	Input             string `json:"input"`
+	ContractAddress   string `json:"contractAddress"`
+	CumulativeGasUsed string `json:"cumulativeGasUsed"`
+	GasUsed           string `json:"gasUsed"`
+	Confirmations     string `json:"confirmations"`
+	MethodID          string `json:"methodId"`
+	FunctionName      string `json:"functionName"`
+}
+
+// newEtherscanTx converts the transaction, which is listed with its receipt.
+// functionName is left empty, as listed transactions are not decoded.
+func newEtherscanTx(tx *pb.Transaction, block *pb.Block, head uint64) etherscanTx {
+	res := etherscanTx{
+		BlockNumber:   strconv.FormatInt(tx.BlockNum, 10),
+		Hash:          tx.TxHash,
+		Nonce:         strconv.FormatInt(tx.Nonce, 10),
+		From:          strings.ToLower(tx.FromAddr),
+		To:            strings.ToLower(tx.ToAddr),
+		Value:         tx.Value,
+		Gas:           strconv.FormatInt(tx.Gas, 10),
+		GasPrice:      tx.GasPrice,
+		IsError:       "0",
+		Input:         tx.Data,
+		Confirmations: confirmations(head, tx.BlockNum),
+		MethodID:      "0x",
+	}
+	if block != nil {
+		res.TimeStamp = strconv.FormatInt(block.BlockTime, 10)
+		res.BlockHash = block.BlockHash
+	}
+	if len(tx.Data) >= 10 {
+		res.MethodID = tx.Data[:10]
+	}
+	if receipt := tx.Receipt; receipt != nil {
+		res.TransactionIndex = strconv.Itoa(int(receipt.TxIndex))
+		if receipt.EffectiveGasPrice != "" {
+			res.GasPrice = receipt.EffectiveGasPrice
+		}
+		if uint64(receipt.Status) != types.ReceiptStatusSuccessful {
+			res.IsError = "1"
+		}
+		res.TxReceiptStatus = strconv.FormatInt(receipt.Status, 10)
+		res.ContractAddress = strings.ToLower(receipt.ContractAddress)
+		res.CumulativeGasUsed = strconv.FormatInt(receipt.CumulativeGasUsed, 10)
+		res.GasUsed = strconv.FormatInt(receipt.GasUsed, 10)
+	}
+	return res
+}
+
+// etherscanTokenTx is an ERC-20 transfer as listed by tokentx.
+type etherscanTokenTx struct {
+	BlockNumber       string `json:"blockNumber"`
+	TimeStamp         string `json:"timeStamp"`
+	Hash              string `json:"hash"`
+	Nonce             string `json:"nonce"`
+	BlockHash         string `json:"blockHash"`
+	From              string `json:"from"`
+	ContractAddress   string `json:"contractAddress"`
+	To                string `json:"to"`
+	Value             string `json:"value"`
+	TokenName         string `json:"tokenName"`
+	TokenSymbol       string `json:"tokenSymbol"`
+	TokenDecimal      string `json:"tokenDecimal"`
+	TransactionIndex  string `json:"transactionIndex"`
+	Gas               string `json:"gas"`
+	GasPrice          string `json:"gasPrice"`
+	GasUsed           string `json:"gasUsed"`
+	CumulativeGasUsed string `json:"cumulativeGasUsed"`
+	Input             string `json:"input"`
+	Confirmations     string `json:"confirmations"`
+}
+
+func newEtherscanTokenTx(transfer *pb.TokenTransfer, tx *pb.Transaction, block *pb.Block, head uint64, token *tokenInfo) etherscanTokenTx {
+	res := etherscanTokenTx{
+		BlockNumber:     strconv.FormatInt(transfer.BlockNum, 10),
+		Hash:            transfer.TxHash,
+		From:            strings.ToLower(transfer.FromAddr),
+		ContractAddress: strings.ToLower(transfer.Token),
+		To:              strings.ToLower(transfer.ToAddr),
+		Value:           transfer.Amount,
+		TokenName:       token.name,
+		TokenSymbol:     token.symbol,
+		TokenDecimal:    token.decimals,
+		// Etherscan no longer returns the input of token transfers
+		Input:         "deprecated",
+		Confirmations: confirmations(head, transfer.BlockNum),
+	}
+	if block != nil {
+		res.TimeStamp = strconv.FormatInt(block.BlockTime, 10)
+		res.BlockHash = block.BlockHash
+	}
+	if tx != nil {
+		etx := newEtherscanTx(tx, block, head)
+		res.Nonce = etx.Nonce
+		res.TransactionIndex = etx.TransactionIndex
+		res.Gas = etx.Gas
+		res.GasPrice = etx.GasPrice
+		res.GasUsed = etx.GasUsed
+		res.CumulativeGasUsed = etx.CumulativeGasUsed
+	}
+	return res
+}
+
+// etherscanInternalTx is an internal transaction as listed by txlistinternal.
+// The input of internal calls is not indexed and is left empty.
+type etherscanInternalTx struct {
+	BlockNumber     string `json:"blockNumber"`
+	TimeStamp       string `json:"timeStamp"`
+	Hash            string `json:"hash"`
+	From            string `json:"from"`
+	To              string `json:"to"`
+	Value           string `json:"value"`
+	ContractAddress string `json:"contractAddress"`
+	Input           string `json:"input"`
+	Type            string `json:"type"`
+	Gas             string `json:"gas"`
+	GasUsed         string `json:"gasUsed"`
+	IsError         string `json:"isError"`
+	ErrCode         string `json:"errCode"`
+}
+
+func newEtherscanInternalTx(tx *pb.InternalTransaction, block *pb.Block) etherscanInternalTx {
+	res := etherscanInternalTx{
+		BlockNumber: strconv.FormatInt(tx.BlockNum, 10),
+		Hash:        tx.TxHash,
+		From:        strings.ToLower(tx.FromAddr),
+		To:          strings.ToLower(tx.ToAddr),
+		Value:       tx.Value,
+		Type:        strings.ToLower(tx.Type),
+		Gas:         strconv.FormatInt(tx.Gas, 10),
+		GasUsed:     strconv.FormatInt(tx.GasUsed, 10),
+		IsError:     "0",
+		ErrCode:     tx.Error,
+	}
+	if block != nil {
+		res.TimeStamp = strconv.FormatInt(block.BlockTime, 10)
+	}
+	// a contract creation lists the created contract instead of a recipient
+	if strings.HasPrefix(res.Type, "create") {
+		res.ContractAddress, res.To = res.To, ""
+	}
+	if tx.Error != "" {
+		res.IsError = "1"
+	}
+	return res
+}
+
+// etherscanLog is a log as listed by getLogs. Unlike the other listings,
+// Etherscan returns its numbers in hex.
+type etherscanLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TimeStamp        string   `json:"timeStamp"`
+	GasPrice         string   `json:"gasPrice"`
+	GasUsed          string   `json:"gasUsed"`
+	LogIndex         string   `json:"logIndex"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+}
+
+func newEtherscanLog(log *pb.Log, tx *pb.Transaction, block *pb.Block) etherscanLog {
+	res := etherscanLog{
+		Address:          strings.ToLower(log.Address),
+		Topics:           log.Topics,
+		Data:             log.Data,
+		BlockNumber:      hexutil.EncodeUint64(uint64(log.BlockNum)),
+		BlockHash:        log.BlockHash,
+		LogIndex:         hexutil.EncodeUint64(uint64(log.Index)),
+		TransactionHash:  log.TxHash,
+		TransactionIndex: hexutil.EncodeUint64(uint64(log.TxIndex)),
+	}
+	if res.Topics == nil {
+		res.Topics = []string{}
+	}
+	if block != nil {
+		res.TimeStamp = hexutil.EncodeUint64(uint64(block.BlockTime))
+	}
+	if tx != nil {
+		gasPrice := tx.GasPrice
+		if tx.Receipt != nil && tx.Receipt.EffectiveGasPrice != "" {
+			gasPrice = tx.Receipt.EffectiveGasPrice
+		}
+		if price := hexBig(gasPrice); price != nil {
+			res.GasPrice = price.String()
+		}
+		if tx.Receipt != nil {
+			res.GasUsed = hexutil.EncodeUint64(uint64(tx.Receipt.GasUsed))
+		}
+	}
+	return res
+}
+
+// confirmations counts the block itself and the blocks on top of it.
+func confirmations(head uint64, num int64) string {
+	if uint64(num) > head {
+		return "0"
+	}
+	return strconv.FormatUint(head-uint64(num)+1, 10)
+}
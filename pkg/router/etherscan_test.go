@@ -0,0 +1,99 @@
+package router
+
+import (
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+)
+
+func TestParseEtherscanPage(t *testing.T) {
+	tests := []struct {
+		query string
+		want  *etherscanPage
+		err   string
+	}{
+		{query: "", want: &etherscanPage{limit: 1000}},
+		{query: "page=0&offset=0", want: &etherscanPage{limit: 1000}},
+		{query: "page=1&offset=100", want: &etherscanPage{limit: 100}},
+		{query: "page=3&offset=100", want: &etherscanPage{limit: 100, offset: 200}},
+		{query: "page=10&offset=100", want: &etherscanPage{limit: 100, offset: 900}},
+		{query: "sort=asc", want: &etherscanPage{limit: 1000}},
+		{query: "sort=desc", want: &etherscanPage{limit: 1000, desc: true}},
+		{query: "page=11&offset=100", err: "Result window is too large, PageNo x Offset size must be less than or equal to 1000"},
+		{query: "offset=1001", err: "Result window is too large, PageNo x Offset size must be less than or equal to 1000"},
+		{query: "page=-1", err: "Error! Invalid page number"},
+		{query: "page=x", err: "Error! Invalid page number"},
+		{query: "offset=-1", err: "Error! Invalid offset"},
+		{query: "sort=newest", err: "Error! Invalid sort order"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/api?"+tt.query, nil)
+			page, err := parseEtherscanPage(c, 1000)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("parseEtherscanPage error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *page != *tt.want {
+				t.Errorf("parseEtherscanPage = %+v, want %+v", page, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeABIString(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode := func(s string) []byte {
+		data, err := abi.Arguments{{Type: stringType}}.Pack(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	word := func(n int64) []byte {
+		return common.BigToHash(new(big.Int).SetInt64(n)).Bytes()
+	}
+	concat := func(words ...[]byte) []byte {
+		var data []byte
+		for _, w := range words {
+			data = append(data, w...)
+		}
+		return data
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"string", encode("Maker"), "Maker"},
+		{"empty string", encode(""), ""},
+		{"long string", encode("Wrapped Ether on a long token name past one word"), "Wrapped Ether on a long token name past one word"},
+		{"bytes32", common.RightPadBytes([]byte("MKR"), 32), "MKR"},
+		{"empty", nil, ""},
+		{"short", []byte("MKR"), ""},
+		{"offset past the data", concat(word(64), word(3)), ""},
+		{"length past the data", concat(word(32), word(33), common.RightPadBytes([]byte("MKR"), 32)), ""},
+		{"invalid utf-8", concat(word(32), word(4), common.RightPadBytes([]byte("MK\xffR"), 32)), "MKR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decodeABIString(tt.data); got != tt.want {
+				t.Errorf("decodeABIString = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,1800 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"Kumazan/go-ethereum-server/pb"
+	"Kumazan/go-ethereum-server/pkg/grpc"
+	"Kumazan/go-ethereum-server/pkg/model"
+	"Kumazan/go-ethereum-server/pkg/node"
+)
+
+// a query may nest blocks, transactions and logs, but not endlessly
+const maxGraphQLDepth = 10
+
+// graphqlSchema is the EIP-1767 schema of go-ethereum. Blocks, transactions
+// and logs are answered from the index. Account state, the pending state,
+// calls, gas prices, ommers, total difficulties and the fields of the blocks
+// and transactions indexed before they were stored are read from the upstream
+// endpoints.
+const graphqlSchema = `
+    # Bytes32 is a 32 byte binary string, represented as 0x-prefixed hexadecimal.
+    scalar Bytes32
+    # Address is a 20 byte Ethereum address, represented as 0x-prefixed hexadecimal.
+    scalar Address
+    # Bytes is an arbitrary length binary string, represented as 0x-prefixed hexadecimal.
+    # An empty byte string is represented as '0x'. Byte strings must have an even number of hexadecimal nybbles.
+    scalar Bytes
+    # BigInt is a large integer. Input is accepted as either a JSON number or as a string.
+    # Strings may be either decimal or 0x-prefixed hexadecimal. Output values are all
+    # 0x-prefixed hexadecimal.
+    scalar BigInt
+    # Long is a 64 bit unsigned integer. Input is accepted as either a JSON number or as a string.
+    # Strings may be either decimal or 0x-prefixed hexadecimal. Output values are all
+    # 0x-prefixed hexadecimal.
+    scalar Long
+
+    schema {
+        query: Query
+        mutation: Mutation
+    }
+
+    # Account is an Ethereum account at a particular block.
+    type Account {
+        # Address is the address owning the account.
+        address: Address!
+        # Balance is the balance of the account, in wei.
+        balance: BigInt!
+        # TransactionCount is the number of transactions sent from this account,
+        # or in the case of a contract, the number of contracts created. Otherwise
+        # known as the nonce.
+        transactionCount: Long!
+        # Code contains the smart contract code for this account, if the account
+        # is a (non-self-destructed) contract.
+        code: Bytes!
+        # Storage provides access to the storage of a contract account, indexed
+        # by its 32 byte slot identifier.
+        storage(slot: Bytes32!): Bytes32!
+    }
+
+    # Log is an Ethereum event log.
+    type Log {
+        # Index is the index of this log in the block.
+        index: Long!
+        # Account is the account which generated this log - this will always
+        # be a contract account.
+        account(block: Long): Account!
+        # Topics is a list of 0-4 indexed topics for the log.
+        topics: [Bytes32!]!
+        # Data is unindexed data for this log.
+        data: Bytes!
+        # Transaction is the transaction that generated this log entry.
+        transaction: Transaction!
+    }
+
+    # EIP-2718
+    type AccessTuple {
+        address: Address!
+        storageKeys : [Bytes32!]!
+    }
+
+    # EIP-4895
+    type Withdrawal {
+        # Index is a monotonically increasing identifier issued by consensus layer.
+        index: Long!
+        # Validator is index of the validator associated with withdrawal.
+        validator: Long!
+        # Recipient address of the withdrawn amount.
+        address: Address!
+        # Amount is the withdrawal value in Gwei.
+        amount: Long!
+    }
+
+    # Transaction is an Ethereum transaction.
+    type Transaction {
+        # Hash is the hash of this transaction.
+        hash: Bytes32!
+        # Nonce is the nonce of the account this transaction was generated with.
+        nonce: Long!
+        # Index is the index of this transaction in the parent block. This will
+        # be null if the transaction has not yet been mined.
+        index: Long
+        # From is the account that sent this transaction - this will always be
+        # an externally owned account.
+        from(block: Long): Account!
+        # To is the account the transaction was sent to. This is null for
+        # contract-creating transactions.
+        to(block: Long): Account
+        # Value is the value, in wei, sent along with this transaction.
+        value: BigInt!
+        # GasPrice is the price offered to miners for gas, in wei per unit.
+        gasPrice: BigInt!
+        # MaxFeePerGas is the maximum fee per gas offered to include a transaction, in wei.
+        maxFeePerGas: BigInt
+        # MaxPriorityFeePerGas is the maximum miner tip per gas offered to include a transaction, in wei.
+        maxPriorityFeePerGas: BigInt
+        # MaxFeePerBlobGas is the maximum blob gas fee cap per blob the sender is willing to pay for blob transaction, in wei.
+        maxFeePerBlobGas: BigInt
+        # EffectiveTip is the actual amount of reward going to miner after considering the max fee cap.
+        effectiveTip: BigInt
+        # Gas is the maximum amount of gas this transaction can consume.
+        gas: Long!
+        # InputData is the data supplied to the target of the transaction.
+        inputData: Bytes!
+        # Block is the block this transaction was mined in. This will be null if
+        # the transaction has not yet been mined.
+        block: Block
+
+        # Status is the return status of the transaction. This will be 1 if the
+        # transaction succeeded, or 0 if it failed (due to a revert, or due to
+        # running out of gas). If the transaction has not yet been mined, this
+        # field will be null.
+        status: Long
+        # GasUsed is the amount of gas that was used processing this transaction.
+        # If the transaction has not yet been mined, this field will be null.
+        gasUsed: Long
+        # CumulativeGasUsed is the total gas used in the block up to and including
+        # this transaction. If the transaction has not yet been mined, this field
+        # will be null.
+        cumulativeGasUsed: Long
+        # EffectiveGasPrice is actual value per gas deducted from the sender's
+        # account. Before EIP-1559, this is equal to the transaction's gas price.
+        # After EIP-1559, it is baseFeePerGas + min(maxFeePerGas - baseFeePerGas,
+        # maxPriorityFeePerGas). Legacy transactions and EIP-2930 transactions are
+        # coerced into the EIP-1559 format by setting both maxFeePerGas and
+        # maxPriorityFeePerGas as the transaction's gas price.
+        effectiveGasPrice: BigInt
+        # BlobGasUsed is the amount of blob gas used by this transaction.
+        blobGasUsed: Long
+        # blobGasPrice is the actual value per blob gas deducted from the senders account.
+        blobGasPrice: BigInt
+        # CreatedContract is the account that was created by a contract creation
+        # transaction. If the transaction was not a contract creation transaction,
+        # or it has not yet been mined, this field will be null.
+        createdContract(block: Long): Account
+        # Logs is a list of log entries emitted by this transaction. If the
+        # transaction has not yet been mined, this field will be null.
+        logs: [Log!]
+        r: BigInt!
+        s: BigInt!
+        v: BigInt!
+        yParity: BigInt
+        # Envelope transaction support
+        type: Long
+        accessList: [AccessTuple!]
+        # Raw is the canonical encoding of the transaction.
+        # For legacy transactions, it returns the RLP encoding.
+        # For EIP-2718 typed transactions, it returns the type and payload.
+        raw: Bytes!
+        # RawReceipt is the canonical encoding of the receipt. For post EIP-2718 typed transactions
+        # this is equivalent to TxType || ReceiptEncoding.
+        rawReceipt: Bytes!
+        # BlobVersionedHashes is a set of hash outputs from the blobs in the transaction.
+        blobVersionedHashes: [Bytes32!]
+    }
+
+    # BlockFilterCriteria encapsulates log filter criteria for a filter applied
+    # to a single block.
+    input BlockFilterCriteria {
+        # Addresses is list of addresses that are of interest. If this list is
+        # empty, results will not be filtered by address.
+        addresses: [Address!]
+        # Topics list restricts matches to particular event topics. Each event has a list
+        # of topics. Topics matches a prefix of that list. An empty element array matches any
+        # topic. Non-empty elements represent an alternative that matches any of the
+        # contained topics.
+        #
+        # Examples:
+        #  - [] or nil          matches any topic list
+        #  - [[A]]              matches topic A in first position
+        #  - [[], [B]]          matches any topic in first position, B in second position
+        #  - [[A], [B]]         matches topic A in first position, B in second position
+        #  - [[A, B]], [C, D]]  matches topic (A OR B) in first position, (C OR D) in second position
+        topics: [[Bytes32!]!]
+    }
+
+    # Block is an Ethereum block.
+    type Block {
+        # Number is the number of this block, starting at 0 for the genesis block.
+        number: Long!
+        # Hash is the block hash of this block.
+        hash: Bytes32!
+        # Parent is the parent block of this block.
+        parent: Block
+        # Nonce is the block nonce, an 8 byte sequence determined by the miner.
+        nonce: Bytes!
+        # TransactionsRoot is the keccak256 hash of the root of the trie of transactions in this block.
+        transactionsRoot: Bytes32!
+        # TransactionCount is the number of transactions in this block. if
+        # transactions are not available for this block, this field will be null.
+        transactionCount: Long
+        # StateRoot is the keccak256 hash of the state trie after this block was processed.
+        stateRoot: Bytes32!
+        # ReceiptsRoot is the keccak256 hash of the trie of transaction receipts in this block.
+        receiptsRoot: Bytes32!
+        # Miner is the account that mined this block.
+        miner(block: Long): Account!
+        # ExtraData is an arbitrary data field supplied by the miner.
+        extraData: Bytes!
+        # GasLimit is the maximum amount of gas that was available to transactions in this block.
+        gasLimit: Long!
+        # GasUsed is the amount of gas that was used executing transactions in this block.
+        gasUsed: Long!
+        # BaseFeePerGas is the fee per unit of gas burned by the protocol in this block.
+        baseFeePerGas: BigInt
+        # NextBaseFeePerGas is the fee per unit of gas which needs to be burned in the next block.
+        nextBaseFeePerGas: BigInt
+        # Timestamp is the unix timestamp at which this block was mined.
+        timestamp: Long!
+        # LogsBloom is a bloom filter that can be used to check if a block may
+        # contain log entries matching a filter.
+        logsBloom: Bytes!
+        # MixHash is the hash that was used as an input to the PoW process.
+        mixHash: Bytes32!
+        # Difficulty is a measure of the difficulty of mining this block.
+        difficulty: BigInt!
+        # TotalDifficulty is the sum of all difficulty values up to and including
+        # this block.
+        totalDifficulty: BigInt!
+        # OmmerCount is the number of ommers (AKA uncles) associated with this
+        # block. If ommers are unavailable, this field will be null.
+        ommerCount: Long
+        # Ommers is a list of ommer (AKA uncle) blocks associated with this block.
+        # If ommers are unavailable, this field will be null. Depending on your
+        # node, the transactions, transactionAt, transactionCount, ommers,
+        # ommerCount and ommerAt fields may not be available on any ommer blocks.
+        ommers: [Block]
+        # OmmerAt returns the ommer (AKA uncle) at the specified index. If ommers
+        # are unavailable, or the index is out of bounds, this field will be null.
+        ommerAt(index: Long!): Block
+        # OmmerHash is the keccak256 hash of all the ommers (AKA uncles)
+        # associated with this block.
+        ommerHash: Bytes32!
+        # Transactions is a list of transactions associated with this block. If
+        # transactions are unavailable for this block, this field will be null.
+        transactions: [Transaction!]
+        # TransactionAt returns the transaction at the specified index. If
+        # transactions are unavailable for this block, or if the index is out of
+        # bounds, this field will be null.
+        transactionAt(index: Long!): Transaction
+        # Logs returns a filtered set of logs from this block.
+        logs(filter: BlockFilterCriteria!): [Log!]!
+        # Account fetches an Ethereum account at the current block's state.
+        account(address: Address!): Account!
+        # Call executes a local call operation at the current block's state.
+        call(data: CallData!): CallResult
+        # EstimateGas estimates the amount of gas that will be required for
+        # successful execution of a transaction at the current block's state.
+        estimateGas(data: CallData!): Long!
+        # RawHeader is the RLP encoding of the block's header.
+        rawHeader: Bytes!
+        # Raw is the RLP encoding of the block.
+        raw: Bytes!
+        # WithdrawalsRoot is the withdrawals trie root in this block.
+        # If withdrawals are unavailable for this block, this field will be null.
+        withdrawalsRoot: Bytes32
+        # Withdrawals is a list of withdrawals associated with this block. If
+        # withdrawals are unavailable for this block, this field will be null.
+        withdrawals: [Withdrawal!]
+        # BlobGasUsed is the total amount of gas used by the transactions.
+        blobGasUsed: Long
+        # ExcessBlobGas is a running total of blob gas consumed in excess of the target, prior to the block.
+        excessBlobGas: Long
+    }
+
+    # CallData represents the data associated with a local contract call.
+    # All fields are optional.
+    input CallData {
+        # From is the address making the call.
+        from: Address
+        # To is the address the call is sent to.
+        to: Address
+        # Gas is the amount of gas sent with the call.
+        gas: Long
+        # GasPrice is the price, in wei, offered for each unit of gas.
+        gasPrice: BigInt
+        # MaxFeePerGas is the maximum fee per gas offered, in wei.
+        maxFeePerGas: BigInt
+        # MaxPriorityFeePerGas is the maximum miner tip per gas offered, in wei.
+        maxPriorityFeePerGas: BigInt
+        # Value is the value, in wei, sent along with the call.
+        value: BigInt
+        # Data is the data sent to the callee.
+        data: Bytes
+    }
+
+    # CallResult is the result of a local call operation.
+    type CallResult {
+        # Data is the return data of the called contract.
+        data: Bytes!
+        # GasUsed is the amount of gas used by the call, after any refunds.
+        gasUsed: Long!
+        # Status is the result of the call - 1 for success or 0 for failure.
+        status: Long!
+    }
+
+    # FilterCriteria encapsulates log filter criteria for searching log entries.
+    input FilterCriteria {
+        # FromBlock is the block at which to start searching, inclusive. Defaults
+        # to the latest block if not supplied.
+        fromBlock: Long
+        # ToBlock is the block at which to stop searching, inclusive. Defaults
+        # to the latest block if not supplied.
+        toBlock: Long
+        # Addresses is a list of addresses that are of interest. If this list is
+        # empty, results will not be filtered by address.
+        addresses: [Address!]
+        # Topics list restricts matches to particular event topics. Each event has a list
+        # of topics. Topics matches a prefix of that list. An empty element array matches any
+        # topic. Non-empty elements represent an alternative that matches any of the
+        # contained topics.
+        #
+        # Examples:
+        #  - [] or nil          matches any topic list
+        #  - [[A]]              matches topic A in first position
+        #  - [[], [B]]          matches any topic in first position, B in second position
+        #  - [[A], [B]]         matches topic A in first position, B in second position
+        #  - [[A, B]], [C, D]]  matches topic (A OR B) in first position, (C OR D) in second position
+        topics: [[Bytes32!]!]
+    }
+
+    # SyncState contains the current synchronisation state of the client.
+    type SyncState {
+        # StartingBlock is the block number at which synchronisation started.
+        startingBlock: Long!
+        # CurrentBlock is the point at which synchronisation has presently reached.
+        currentBlock: Long!
+        # HighestBlock is the latest known block number.
+        highestBlock: Long!
+    }
+
+    # Pending represents the current pending state.
+    type Pending {
+        # TransactionCount is the number of transactions in the pending state.
+        transactionCount: Long!
+        # Transactions is a list of transactions in the current pending state.
+        transactions: [Transaction!]
+        # Account fetches an Ethereum account for the pending state.
+        account(address: Address!): Account!
+        # Call executes a local call operation for the pending state.
+        call(data: CallData!): CallResult
+        # EstimateGas estimates the amount of gas that will be required for
+        # successful execution of a transaction for the pending state.
+        estimateGas(data: CallData!): Long!
+    }
+
+    type Query {
+        # Block fetches an Ethereum block by number or by hash. If neither is
+        # supplied, the most recent known block is returned.
+        block(number: Long, hash: Bytes32): Block
+        # Blocks returns all the blocks between two numbers, inclusive. If
+        # to is not supplied, it defaults to the most recent known block.
+        blocks(from: Long, to: Long): [Block!]!
+        # Pending returns the current pending state.
+        pending: Pending!
+        # Transaction returns a transaction specified by its hash.
+        transaction(hash: Bytes32!): Transaction
+        # Logs returns log entries matching the provided filter.
+        logs(filter: FilterCriteria!): [Log!]!
+        # GasPrice returns the node's estimate of a gas price sufficient to
+        # ensure a transaction is mined in a timely fashion.
+        gasPrice: BigInt!
+        # MaxPriorityFeePerGas returns the node's estimate of a gas tip sufficient
+        # to ensure a transaction is mined in a timely fashion.
+        maxPriorityFeePerGas: BigInt!
+        # Syncing returns information on the current synchronisation state.
+        syncing: SyncState
+        # ChainID returns the current chain ID for transaction replay protection.
+        chainID: BigInt!
+    }
+
+    type Mutation {
+        # SendRawTransaction sends an RLP-encoded transaction to the network.
+        sendRawTransaction(data: Bytes!): Bytes32!
+    }
+`
+
+var (
+	errNoUpstream     = errors.New("not indexed and no upstream is configured")
+	errUpstreamBlock  = errors.New("block not found upstream")
+	errOmmerBody      = errors.New("the body of an ommer is not available")
+	errUpstreamSyntax = errors.New("unexpected upstream response")
+)
+
+func newGraphQLSchema(ec *grpc.EthereumClient, upstream *node.Pool) *graphql.Schema {
+	resolver := &gqlResolver{ec: ec, upstream: upstream}
+	return graphql.MustParseSchema(graphqlSchema, resolver, graphql.MaxDepth(maxGraphQLDepth))
+}
+
+// serveGraphQL executes a GraphQL query against the index.
+func (h *Handler) serveGraphQL(c *gin.Context) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := c.ShouldBindJSON(&params); err != nil || params.Query == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "query is invalid",
+		})
+		return
+	}
+
+	resp := h.schema.Exec(c.Request.Context(), params.Query, params.OperationName, params.Variables)
+	c.JSON(http.StatusOK, resp)
+}
+
+// long is a GraphQL Long argument.
+type long int64
+
+func (long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+func (l *long) UnmarshalGraphQL(input interface{}) error {
+	var err error
+	switch input := input.(type) {
+	case string:
+		var num uint64
+		if strings.HasPrefix(input, "0x") {
+			num, err = hexutil.DecodeUint64(input)
+		} else {
+			num, err = strconv.ParseUint(input, 10, 63)
+		}
+		*l = long(num)
+	case int32:
+		*l = long(input)
+	case int64:
+		*l = long(input)
+	case float64:
+		*l = long(input)
+	default:
+		err = fmt.Errorf("unexpected type %T for Long", input)
+	}
+	if err == nil && *l < 0 {
+		err = errors.New("Long is negative")
+	}
+	return err
+}
+
+type gqlResolver struct {
+	ec       *grpc.EthereumClient
+	upstream *node.Pool
+}
+
+// call calls an upstream method for what the index does not hold.
+func (r *gqlResolver) call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if r.upstream == nil {
+		return errNoUpstream
+	}
+	return r.upstream.CallContext(ctx, result, method, args...)
+}
+
+func (r *gqlResolver) Block(ctx context.Context, args struct {
+	Number *long
+	Hash   *common.Hash
+}) (*gqlBlock, error) {
+	if args.Hash != nil {
+		return r.blockByHash(ctx, args.Hash.String())
+	}
+	var num uint64
+	if args.Number != nil {
+		num = uint64(*args.Number)
+	} else {
+		var err error
+		if num, err = headNumber(ctx, r.ec, model.FinalityLatest); err != nil {
+			return nil, err
+		}
+	}
+	return r.blockByNumber(ctx, num)
+}
+
+func (r *gqlResolver) blockByNumber(ctx context.Context, num uint64) (*gqlBlock, error) {
+	resp, err := r.ec.GetBlock(ctx, &pb.GetBlockRequest{BlockNum: int64(num)})
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &gqlBlock{r: r, header: resp.Block}, nil
+}
+
+func (r *gqlResolver) blockByHash(ctx context.Context, hash string) (*gqlBlock, error) {
+	resp, err := r.ec.GetBlockByHash(ctx, &pb.GetBlockByHashRequest{BlockHash: hash})
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &gqlBlock{r: r, header: resp.Block}, nil
+}
+
+func (r *gqlResolver) Blocks(ctx context.Context, args struct {
+	From *long
+	To   *long
+}) ([]*gqlBlock, error) {
+	if args.From == nil {
+		return nil, errors.New("from is required")
+	}
+	req := &pb.ListBlocksRequest{FromBlock: int64(*args.From)}
+	if args.To != nil {
+		if *args.To < *args.From {
+			return []*gqlBlock{}, nil
+		}
+		req.ToBlock = blockValue(int64(*args.To))
+	}
+	resp, err := r.ec.ListBlocks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// listed newest first, returned in ascending order
+	blocks := make([]*gqlBlock, len(resp.Blocks))
+	for i, block := range resp.Blocks {
+		blocks[len(blocks)-1-i] = &gqlBlock{r: r, header: block}
+	}
+	return blocks, nil
+}
+
+func (r *gqlResolver) Pending() *gqlPending {
+	return &gqlPending{r: r}
+}
+
+func (r *gqlResolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*gqlTransaction, error) {
+	return r.transaction(ctx, args.Hash.String())
+}
+
+// transaction returns the indexed transaction of the hash, or else the one
+// pending on the upstream endpoints.
+func (r *gqlResolver) transaction(ctx context.Context, hash string) (*gqlTransaction, error) {
+	resp, err := r.ec.GetTransaction(ctx, &pb.GetTransactionRequest{TxHash: hash, Receipt: true})
+	if isNotFound(err) {
+		return r.pendingTransaction(ctx, hash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &gqlTransaction{r: r, tx: resp.Tx}, nil
+}
+
+// pendingTransaction returns the transaction of the hash from the pool of the
+// upstream endpoints, nil when it is not pending there.
+func (r *gqlResolver) pendingTransaction(ctx context.Context, hash string) (*gqlTransaction, error) {
+	if r.upstream == nil {
+		return nil, nil
+	}
+	var raw json.RawMessage
+	if err := r.call(ctx, &raw, "eth_getTransactionByHash", hash); err != nil {
+		return nil, err
+	}
+	var tx *struct {
+		BlockHash *common.Hash `json:"blockHash"`
+	}
+	if err := json.Unmarshal(raw, &tx); err != nil {
+		return nil, err
+	}
+	// a transaction mined since it was looked up in the index is not pending
+	if tx == nil || tx.BlockHash != nil {
+		return nil, nil
+	}
+	return r.newPendingTransaction(raw)
+}
+
+// newPendingTransaction parses a pending transaction returned by the upstream
+// endpoints.
+func (r *gqlResolver) newPendingTransaction(raw json.RawMessage) (*gqlTransaction, error) {
+	var sender struct {
+		From common.Address `json:"from"`
+	}
+	if err := json.Unmarshal(raw, &sender); err != nil {
+		return nil, err
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	return &gqlTransaction{r: r, tx: newPbPendingTransaction(tx, sender.From), pending: true}, nil
+}
+
+// newPbPendingTransaction converts a pending transaction to the form of the
+// indexed ones, without block or receipt.
+func newPbPendingTransaction(tx *types.Transaction, from common.Address) *pb.Transaction {
+	res := &pb.Transaction{
+		TxHash:   tx.Hash().String(),
+		Type:     int32(tx.Type()),
+		FromAddr: from.String(),
+		Nonce:    int64(tx.Nonce()),
+		Gas:      int64(tx.Gas()),
+		GasPrice: tx.GasPrice().String(),
+		Data:     hexutil.Encode(tx.Data()),
+		Value:    tx.Value().String(),
+	}
+	if to := tx.To(); to != nil {
+		res.ToAddr = to.String()
+	}
+	switch tx.Type() {
+	case types.DynamicFeeTxType, types.BlobTxType:
+		res.MaxFeePerGas = tx.GasFeeCap().String()
+		res.MaxPriorityFeePerGas = tx.GasTipCap().String()
+	}
+	if tx.Type() == types.BlobTxType {
+		res.MaxFeePerBlobGas = tx.BlobGasFeeCap().String()
+	}
+	if tx.Protected() {
+		res.ChainId = tx.ChainId().String()
+	}
+	v, r, s := tx.RawSignatureValues()
+	res.V, res.R, res.S = v.String(), r.String(), s.String()
+	for _, tuple := range tx.AccessList() {
+		keys := make([]string, len(tuple.StorageKeys))
+		for i, key := range tuple.StorageKeys {
+			keys[i] = key.String()
+		}
+		res.AccessList = append(res.AccessList, &pb.AccessTuple{Address: tuple.Address.String(), StorageKeys: keys})
+	}
+	for _, hash := range tx.BlobHashes() {
+		res.BlobHashes = append(res.BlobHashes, hash.String())
+	}
+	return res
+}
+
+func (r *gqlResolver) Logs(ctx context.Context, args struct {
+	Filter struct {
+		FromBlock *long
+		ToBlock   *long
+		Addresses *[]common.Address
+		Topics    *[][]common.Hash
+	}
+}) ([]*gqlLog, error) {
+	req := &pb.GetLogsRequest{}
+	if args.Filter.FromBlock != nil {
+		req.FromBlock = blockValue(int64(*args.Filter.FromBlock))
+	}
+	if args.Filter.ToBlock != nil {
+		req.ToBlock = blockValue(int64(*args.Filter.ToBlock))
+	}
+	return r.logs(ctx, req, args.Filter.Addresses, args.Filter.Topics)
+}
+
+// logs completes req with the addresses and topics of a filter and returns
+// the matching logs.
+func (r *gqlResolver) logs(ctx context.Context, req *pb.GetLogsRequest, addresses *[]common.Address, topics *[][]common.Hash) ([]*gqlLog, error) {
+	if addresses != nil {
+		if len(*addresses) > maxFilterAddresses {
+			return nil, errors.New("addresses is invalid")
+		}
+		for _, address := range *addresses {
+			req.Addresses = append(req.Addresses, address.String())
+		}
+	}
+	if topics != nil {
+		if len(*topics) > 4 {
+			return nil, errors.New("topics is invalid")
+		}
+		for _, position := range *topics {
+			if len(position) > maxFilterTopics {
+				return nil, errors.New("topics is invalid")
+			}
+			filter := &pb.TopicFilter{}
+			for _, topic := range position {
+				filter.Topics = append(filter.Topics, topic.String())
+			}
+			req.Topics = append(req.Topics, filter)
+		}
+	}
+	req.Limit = maxLogsLimit + 1
+
+	resp, err := r.ec.GetLogs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Logs) > maxLogsLimit {
+		return nil, fmt.Errorf("query returned more than %d results", maxLogsLimit)
+	}
+	logs := make([]*gqlLog, len(resp.Logs))
+	for i, log := range resp.Logs {
+		logs[i] = &gqlLog{r: r, log: log}
+	}
+	return logs, nil
+}
+
+func (r *gqlResolver) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	var price hexutil.Big
+	err := r.call(ctx, &price, "eth_gasPrice")
+	return price, err
+}
+
+func (r *gqlResolver) MaxPriorityFeePerGas(ctx context.Context) (hexutil.Big, error) {
+	var tip hexutil.Big
+	err := r.call(ctx, &tip, "eth_maxPriorityFeePerGas")
+	return tip, err
+}
+
+func (r *gqlResolver) Syncing(ctx context.Context) (*gqlSyncState, error) {
+	var raw json.RawMessage
+	if err := r.call(ctx, &raw, "eth_syncing"); err != nil {
+		return nil, err
+	}
+	// a node in sync answers false
+	var synced bool
+	if json.Unmarshal(raw, &synced) == nil {
+		return nil, nil
+	}
+	state := new(gqlSyncState)
+	if err := json.Unmarshal(raw, &state.progress); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (r *gqlResolver) ChainID(ctx context.Context) (hexutil.Big, error) {
+	var chainID hexutil.Big
+	err := r.call(ctx, &chainID, "eth_chainId")
+	return chainID, err
+}
+
+func (r *gqlResolver) SendRawTransaction(ctx context.Context, args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	var hash common.Hash
+	err := r.call(ctx, &hash, "eth_sendRawTransaction", args.Data)
+	return hash, err
+}
+
+// gqlCallData is the CallData input, sent upstream as the call object of
+// eth_call and eth_estimateGas.
+type gqlCallData struct {
+	From                 *common.Address `json:"from,omitempty"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  *hexutil.Uint64 `json:"gas,omitempty"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value,omitempty"`
+	Data                 *hexutil.Bytes  `json:"data,omitempty"`
+}
+
+// ethCall runs the call on the upstream endpoints at the block tag. A
+// reverted call has status 0 and the revert data. The gas used of a call is
+// not returned by eth_call, the gas estimated for it is returned instead.
+func (r *gqlResolver) ethCall(ctx context.Context, data gqlCallData, tag string) (*gqlCallResult, error) {
+	var result hexutil.Bytes
+	err := r.call(ctx, &result, "eth_call", data, tag)
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		revert, _ := dataErr.ErrorData().(string)
+		res := &gqlCallResult{}
+		res.data, _ = hexutil.Decode(revert)
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	gasUsed, err := r.estimateGas(ctx, data, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlCallResult{data: result, gasUsed: gasUsed, status: 1}, nil
+}
+
+func (r *gqlResolver) estimateGas(ctx context.Context, data gqlCallData, tag string) (hexutil.Uint64, error) {
+	var gas hexutil.Uint64
+	err := r.call(ctx, &gas, "eth_estimateGas", data, tag)
+	return gas, err
+}
+
+type gqlCallResult struct {
+	data    hexutil.Bytes
+	gasUsed hexutil.Uint64
+	status  hexutil.Uint64
+}
+
+func (c *gqlCallResult) Data() hexutil.Bytes {
+	return c.data
+}
+
+func (c *gqlCallResult) GasUsed() hexutil.Uint64 {
+	return c.gasUsed
+}
+
+func (c *gqlCallResult) Status() hexutil.Uint64 {
+	return c.status
+}
+
+// gqlSyncState is the progress of an upstream endpoint still syncing.
+type gqlSyncState struct {
+	progress struct {
+		StartingBlock hexutil.Uint64 `json:"startingBlock"`
+		CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+		HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+	}
+}
+
+func (s *gqlSyncState) StartingBlock() hexutil.Uint64 {
+	return s.progress.StartingBlock
+}
+
+func (s *gqlSyncState) CurrentBlock() hexutil.Uint64 {
+	return s.progress.CurrentBlock
+}
+
+func (s *gqlSyncState) HighestBlock() hexutil.Uint64 {
+	return s.progress.HighestBlock
+}
+
+// gqlPending is the pending state of the upstream endpoints, as the index
+// only holds mined blocks.
+type gqlPending struct {
+	r *gqlResolver
+}
+
+func (p *gqlPending) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	var count hexutil.Uint64
+	err := p.r.call(ctx, &count, "eth_getBlockTransactionCountByNumber", "pending")
+	return count, err
+}
+
+func (p *gqlPending) Transactions(ctx context.Context) (*[]*gqlTransaction, error) {
+	var block *struct {
+		Transactions []json.RawMessage `json:"transactions"`
+	}
+	if err := p.r.call(ctx, &block, "eth_getBlockByNumber", "pending", true); err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, nil
+	}
+	txs := make([]*gqlTransaction, len(block.Transactions))
+	for i, raw := range block.Transactions {
+		var err error
+		if txs[i], err = p.r.newPendingTransaction(raw); err != nil {
+			return nil, err
+		}
+	}
+	return &txs, nil
+}
+
+func (p *gqlPending) Account(args struct{ Address common.Address }) *gqlAccount {
+	return &gqlAccount{r: p.r, address: args.Address, pending: true}
+}
+
+func (p *gqlPending) Call(ctx context.Context, args struct{ Data gqlCallData }) (*gqlCallResult, error) {
+	return p.r.ethCall(ctx, args.Data, "pending")
+}
+
+func (p *gqlPending) EstimateGas(ctx context.Context, args struct{ Data gqlCallData }) (hexutil.Uint64, error) {
+	return p.r.estimateGas(ctx, args.Data, "pending")
+}
+
+// gqlBlock is a block, whose transactions are only fetched when asked for. An
+// ommer is not indexed, its header is read from the upstream endpoints and it
+// has no transactions.
+type gqlBlock struct {
+	r      *gqlResolver
+	header *pb.Block
+	ommer  bool
+
+	mu      sync.Mutex
+	txs     []*gqlTransaction
+	details *blockDetails
+}
+
+// blockDetails are the header, ommers and withdrawals of a block.
+type blockDetails struct {
+	header      *types.Header
+	uncles      []common.Hash
+	withdrawals []*types.Withdrawal
+}
+
+// parseBlockDetails parses a block or header in its JSON-RPC form.
+func parseBlockDetails(raw []byte) (*blockDetails, error) {
+	header := new(types.Header)
+	if err := json.Unmarshal(raw, header); err != nil {
+		return nil, err
+	}
+	var body struct {
+		Uncles      []common.Hash       `json:"uncles"`
+		Withdrawals []*types.Withdrawal `json:"withdrawals"`
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, err
+	}
+	return &blockDetails{header: header, uncles: body.Uncles, withdrawals: body.Withdrawals}, nil
+}
+
+// blockDetails returns the header, ommers and withdrawals of the block, read
+// from the upstream endpoints for a block indexed before its header was
+// stored.
+func (b *gqlBlock) blockDetails(ctx context.Context) (*blockDetails, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.details != nil {
+		return b.details, nil
+	}
+
+	var raw json.RawMessage
+	block, err := newRPCBlock(b.header, false)
+	if err == errIncomplete {
+		if err := b.r.call(ctx, &raw, "eth_getBlockByHash", b.header.BlockHash, false); err != nil {
+			return nil, err
+		}
+		if string(raw) == "null" {
+			return nil, errUpstreamBlock
+		}
+	} else if raw, err = json.Marshal(block); err != nil {
+		return nil, err
+	}
+	details, err := parseBlockDetails(raw)
+	if err != nil {
+		return nil, err
+	}
+	b.details = details
+	return details, nil
+}
+
+// headerField returns the header of the block for a field resolver.
+func (b *gqlBlock) headerField(ctx context.Context) (*types.Header, error) {
+	details, err := b.blockDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return details.header, nil
+}
+
+// transactions fetches the transactions of the block with their receipts,
+// once. An ommer has none.
+func (b *gqlBlock) transactions(ctx context.Context) ([]*gqlTransaction, error) {
+	if b.ommer {
+		return nil, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.txs != nil {
+		return b.txs, nil
+	}
+
+	req := &pb.GetBlockByHashRequest{BlockHash: b.header.BlockHash, FullTransactions: true, Receipts: true}
+	resp, err := b.r.ec.GetBlockByHash(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]*gqlTransaction, len(resp.Block.FullTransactions))
+	for i, tx := range resp.Block.FullTransactions {
+		index := uint64(i)
+		txs[i] = &gqlTransaction{r: b.r, tx: tx, index: &index, block: b}
+	}
+	b.txs = txs
+	return txs, nil
+}
+
+// ommerBlock returns the ommer of the block at the index, read from the upstream
+// endpoints.
+func (b *gqlBlock) ommerBlock(ctx context.Context, index int) (*gqlBlock, error) {
+	var raw json.RawMessage
+	err := b.r.call(ctx, &raw, "eth_getUncleByBlockHashAndIndex", b.header.BlockHash, hexutil.Uint64(index))
+	if err != nil {
+		return nil, err
+	}
+	if string(raw) == "null" {
+		return nil, errUpstreamBlock
+	}
+	details, err := parseBlockDetails(raw)
+	if err != nil {
+		return nil, err
+	}
+	header := details.header
+	return &gqlBlock{
+		r: b.r,
+		header: &pb.Block{
+			BlockNum:   header.Number.Int64(),
+			BlockHash:  header.Hash().String(),
+			BlockTime:  int64(header.Time),
+			ParentHash: header.ParentHash.String(),
+		},
+		ommer:   true,
+		details: details,
+	}, nil
+}
+
+func (b *gqlBlock) Number() hexutil.Uint64 {
+	return hexutil.Uint64(b.header.BlockNum)
+}
+
+func (b *gqlBlock) Hash() common.Hash {
+	return common.HexToHash(b.header.BlockHash)
+}
+
+func (b *gqlBlock) Parent(ctx context.Context) (*gqlBlock, error) {
+	if b.header.BlockNum == 0 {
+		return nil, nil
+	}
+	return b.r.blockByHash(ctx, b.header.ParentHash)
+}
+
+func (b *gqlBlock) Nonce(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return header.Nonce[:], nil
+}
+
+func (b *gqlBlock) TransactionsRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.TxHash, nil
+}
+
+func (b *gqlBlock) TransactionCount(ctx context.Context) (*hexutil.Uint64, error) {
+	if b.ommer {
+		return nil, nil
+	}
+	txs, err := b.transactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(len(txs))
+	return &count, nil
+}
+
+func (b *gqlBlock) StateRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Root, nil
+}
+
+func (b *gqlBlock) ReceiptsRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.ReceiptHash, nil
+}
+
+// Miner is the account of the miner at this block, unless another is asked
+// for.
+func (b *gqlBlock) Miner(ctx context.Context, args struct{ Block *long }) (*gqlAccount, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	block := args.Block
+	if block == nil {
+		num := long(b.header.BlockNum)
+		block = &num
+	}
+	return newGQLAccount(b.r, header.Coinbase, block), nil
+}
+
+func (b *gqlBlock) ExtraData(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return header.Extra, nil
+}
+
+func (b *gqlBlock) GasLimit(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.GasLimit), nil
+}
+
+func (b *gqlBlock) GasUsed(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.GasUsed), nil
+}
+
+func (b *gqlBlock) BaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	header, err := b.headerField(ctx)
+	if err != nil || header.BaseFee == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(header.BaseFee), nil
+}
+
+// NextBaseFeePerGas is computed from the block, and null before London.
+func (b *gqlBlock) NextBaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	header, err := b.headerField(ctx)
+	if err != nil || header.BaseFee == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(nextBaseFee(header)), nil
+}
+
+// nextBaseFee is the EIP-1559 base fee of the block after the London header,
+// raised or lowered by how far its gas used is from the target.
+func nextBaseFee(header *types.Header) *big.Int {
+	target := header.GasLimit / params.DefaultElasticityMultiplier
+	if header.GasUsed == target {
+		return new(big.Int).Set(header.BaseFee)
+	}
+	delta := new(big.Int).SetUint64(header.GasUsed)
+	delta.Sub(delta, new(big.Int).SetUint64(target)).Abs(delta)
+	delta.Mul(delta, header.BaseFee)
+	delta.Div(delta, new(big.Int).SetUint64(target))
+	delta.Div(delta, big.NewInt(params.DefaultBaseFeeChangeDenominator))
+	if header.GasUsed > target {
+		if delta.Sign() == 0 {
+			delta.SetInt64(1)
+		}
+		return delta.Add(delta, header.BaseFee)
+	}
+	delta.Sub(header.BaseFee, delta)
+	if delta.Sign() < 0 {
+		delta.SetInt64(0)
+	}
+	return delta
+}
+
+func (b *gqlBlock) Timestamp() hexutil.Uint64 {
+	return hexutil.Uint64(b.header.BlockTime)
+}
+
+func (b *gqlBlock) LogsBloom(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return header.Bloom.Bytes(), nil
+}
+
+func (b *gqlBlock) MixHash(ctx context.Context) (common.Hash, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.MixDigest, nil
+}
+
+func (b *gqlBlock) Difficulty(ctx context.Context) (hexutil.Big, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*header.Difficulty), nil
+}
+
+// TotalDifficulty is not indexed, it is read from the upstream endpoints.
+func (b *gqlBlock) TotalDifficulty(ctx context.Context) (hexutil.Big, error) {
+	var block *struct {
+		TotalDifficulty *hexutil.Big `json:"totalDifficulty"`
+	}
+	if err := b.r.call(ctx, &block, "eth_getBlockByHash", b.header.BlockHash, false); err != nil {
+		return hexutil.Big{}, err
+	}
+	if block == nil {
+		return hexutil.Big{}, errUpstreamBlock
+	}
+	if block.TotalDifficulty == nil {
+		return hexutil.Big{}, errUpstreamSyntax
+	}
+	return *block.TotalDifficulty, nil
+}
+
+func (b *gqlBlock) OmmerCount(ctx context.Context) (*hexutil.Uint64, error) {
+	details, err := b.blockDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(len(details.uncles))
+	return &count, nil
+}
+
+func (b *gqlBlock) Ommers(ctx context.Context) (*[]*gqlBlock, error) {
+	details, err := b.blockDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ommers := make([]*gqlBlock, len(details.uncles))
+	for i := range details.uncles {
+		if ommers[i], err = b.ommerBlock(ctx, i); err != nil {
+			return nil, err
+		}
+	}
+	return &ommers, nil
+}
+
+func (b *gqlBlock) OmmerAt(ctx context.Context, args struct{ Index long }) (*gqlBlock, error) {
+	details, err := b.blockDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if int64(args.Index) >= int64(len(details.uncles)) {
+		return nil, nil
+	}
+	return b.ommerBlock(ctx, int(args.Index))
+}
+
+func (b *gqlBlock) OmmerHash(ctx context.Context) (common.Hash, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.UncleHash, nil
+}
+
+func (b *gqlBlock) Transactions(ctx context.Context) (*[]*gqlTransaction, error) {
+	if b.ommer {
+		return nil, nil
+	}
+	txs, err := b.transactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &txs, nil
+}
+
+func (b *gqlBlock) TransactionAt(ctx context.Context, args struct{ Index long }) (*gqlTransaction, error) {
+	txs, err := b.transactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if int64(args.Index) >= int64(len(txs)) {
+		return nil, nil
+	}
+	return txs[args.Index], nil
+}
+
+func (b *gqlBlock) Logs(ctx context.Context, args struct {
+	Filter struct {
+		Addresses *[]common.Address
+		Topics    *[][]common.Hash
+	}
+}) ([]*gqlLog, error) {
+	// the logs indexed at the number of an ommer are of the canonical block
+	if b.ommer {
+		return []*gqlLog{}, nil
+	}
+	num := wrapperspb.UInt64(uint64(b.header.BlockNum))
+	req := &pb.GetLogsRequest{FromBlock: num, ToBlock: num}
+	return b.r.logs(ctx, req, args.Filter.Addresses, args.Filter.Topics)
+}
+
+func (b *gqlBlock) Account(args struct{ Address common.Address }) *gqlAccount {
+	num := uint64(b.header.BlockNum)
+	return &gqlAccount{r: b.r, address: args.Address, block: &num}
+}
+
+func (b *gqlBlock) Call(ctx context.Context, args struct{ Data gqlCallData }) (*gqlCallResult, error) {
+	return b.r.ethCall(ctx, args.Data, hexutil.EncodeUint64(uint64(b.header.BlockNum)))
+}
+
+func (b *gqlBlock) EstimateGas(ctx context.Context, args struct{ Data gqlCallData }) (hexutil.Uint64, error) {
+	return b.r.estimateGas(ctx, args.Data, hexutil.EncodeUint64(uint64(b.header.BlockNum)))
+}
+
+func (b *gqlBlock) RawHeader(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(header)
+}
+
+func (b *gqlBlock) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	if b.ommer {
+		return nil, errOmmerBody
+	}
+	details, err := b.blockDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := b.transactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signed := make([]*types.Transaction, len(txs))
+	for i, tx := range txs {
+		if signed[i], err = tx.signed(ctx); err != nil {
+			return nil, err
+		}
+	}
+	uncles := make([]*types.Header, len(details.uncles))
+	for i := range details.uncles {
+		ommer, err := b.ommerBlock(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		uncles[i] = ommer.details.header
+	}
+	block := types.NewBlockWithHeader(details.header).WithBody(signed, uncles)
+	if details.header.WithdrawalsHash != nil {
+		block = block.WithWithdrawals(details.withdrawals)
+	}
+	return rlp.EncodeToBytes(block)
+}
+
+func (b *gqlBlock) WithdrawalsRoot(ctx context.Context) (*common.Hash, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return header.WithdrawalsHash, nil
+}
+
+func (b *gqlBlock) Withdrawals(ctx context.Context) (*[]*gqlWithdrawal, error) {
+	details, err := b.blockDetails(ctx)
+	if err != nil || details.header.WithdrawalsHash == nil {
+		return nil, err
+	}
+	withdrawals := make([]*gqlWithdrawal, len(details.withdrawals))
+	for i, w := range details.withdrawals {
+		withdrawals[i] = &gqlWithdrawal{w: w}
+	}
+	return &withdrawals, nil
+}
+
+func (b *gqlBlock) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Uint64)(header.BlobGasUsed), nil
+}
+
+func (b *gqlBlock) ExcessBlobGas(ctx context.Context) (*hexutil.Uint64, error) {
+	header, err := b.headerField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Uint64)(header.ExcessBlobGas), nil
+}
+
+type gqlWithdrawal struct {
+	w *types.Withdrawal
+}
+
+func (w *gqlWithdrawal) Index() hexutil.Uint64 {
+	return hexutil.Uint64(w.w.Index)
+}
+
+func (w *gqlWithdrawal) Validator() hexutil.Uint64 {
+	return hexutil.Uint64(w.w.Validator)
+}
+
+func (w *gqlWithdrawal) Address() common.Address {
+	return w.w.Address
+}
+
+func (w *gqlWithdrawal) Amount() hexutil.Uint64 {
+	return hexutil.Uint64(w.w.Amount)
+}
+
+// gqlTransaction is a mined transaction with its receipt, or a pending one of
+// the upstream endpoints.
+type gqlTransaction struct {
+	r       *gqlResolver
+	tx      *pb.Transaction
+	index   *uint64
+	block   *gqlBlock
+	pending bool
+}
+
+// signed returns the transaction with its signature, read from the upstream
+// endpoints for a transaction indexed before its signature was stored.
+func (t *gqlTransaction) signed(ctx context.Context) (*types.Transaction, error) {
+	var raw json.RawMessage
+	rpcTx, err := newRPCTransaction(t.tx, "", 0)
+	if err == errIncomplete {
+		if err := t.r.call(ctx, &raw, "eth_getTransactionByHash", t.tx.TxHash); err != nil {
+			return nil, err
+		}
+	} else if raw, err = json.Marshal(rpcTx); err != nil {
+		return nil, err
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (t *gqlTransaction) Hash() common.Hash {
+	return common.HexToHash(t.tx.TxHash)
+}
+
+func (t *gqlTransaction) Nonce() hexutil.Uint64 {
+	return hexutil.Uint64(t.tx.Nonce)
+}
+
+func (t *gqlTransaction) Index() *hexutil.Uint64 {
+	if t.index != nil {
+		index := hexutil.Uint64(*t.index)
+		return &index
+	}
+	if t.tx.Receipt == nil {
+		return nil
+	}
+	index := hexutil.Uint64(t.tx.Receipt.TxIndex)
+	return &index
+}
+
+func (t *gqlTransaction) From(args struct{ Block *long }) *gqlAccount {
+	return newGQLAccount(t.r, common.HexToAddress(t.tx.FromAddr), args.Block)
+}
+
+func (t *gqlTransaction) To(args struct{ Block *long }) *gqlAccount {
+	if t.tx.ToAddr == "" {
+		return nil
+	}
+	return newGQLAccount(t.r, common.HexToAddress(t.tx.ToAddr), args.Block)
+}
+
+func (t *gqlTransaction) Value() hexutil.Big {
+	return bigOrZero(hexBig(t.tx.Value))
+}
+
+func (t *gqlTransaction) GasPrice() hexutil.Big {
+	if t.tx.Receipt != nil && t.tx.Receipt.EffectiveGasPrice != "" {
+		return bigOrZero(hexBig(t.tx.Receipt.EffectiveGasPrice))
+	}
+	return bigOrZero(hexBig(t.tx.GasPrice))
+}
+
+func (t *gqlTransaction) MaxFeePerGas() *hexutil.Big {
+	return hexBig(t.tx.MaxFeePerGas)
+}
+
+func (t *gqlTransaction) MaxPriorityFeePerGas() *hexutil.Big {
+	return hexBig(t.tx.MaxPriorityFeePerGas)
+}
+
+func (t *gqlTransaction) MaxFeePerBlobGas() *hexutil.Big {
+	return hexBig(t.tx.MaxFeePerBlobGas)
+}
+
+// EffectiveTip is the price paid above the base fee of the block, null while
+// pending.
+func (t *gqlTransaction) EffectiveTip(ctx context.Context) (*hexutil.Big, error) {
+	block, err := t.Block(ctx)
+	if err != nil || block == nil || t.tx.Receipt == nil {
+		return nil, err
+	}
+	header, err := block.headerField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	price := t.GasPrice()
+	if header.BaseFee == nil {
+		return &price, nil
+	}
+	tip := new(big.Int).Sub(price.ToInt(), header.BaseFee)
+	return (*hexutil.Big)(tip), nil
+}
+
+func (t *gqlTransaction) Gas() hexutil.Uint64 {
+	return hexutil.Uint64(t.tx.Gas)
+}
+
+func (t *gqlTransaction) InputData() hexutil.Bytes {
+	data, _ := hexutil.Decode(t.tx.Data)
+	return data
+}
+
+func (t *gqlTransaction) Block(ctx context.Context) (*gqlBlock, error) {
+	if t.pending {
+		return nil, nil
+	}
+	if t.block != nil {
+		return t.block, nil
+	}
+	if t.tx.Receipt != nil {
+		return t.r.blockByHash(ctx, t.tx.Receipt.BlockHash)
+	}
+	return t.r.blockByNumber(ctx, uint64(t.tx.BlockNum))
+}
+
+func (t *gqlTransaction) Status() *hexutil.Uint64 {
+	if t.tx.Receipt == nil {
+		return nil
+	}
+	status := hexutil.Uint64(t.tx.Receipt.Status)
+	return &status
+}
+
+func (t *gqlTransaction) GasUsed() *hexutil.Uint64 {
+	if t.tx.Receipt == nil {
+		return nil
+	}
+	gasUsed := hexutil.Uint64(t.tx.Receipt.GasUsed)
+	return &gasUsed
+}
+
+func (t *gqlTransaction) CumulativeGasUsed() *hexutil.Uint64 {
+	if t.tx.Receipt == nil {
+		return nil
+	}
+	gasUsed := hexutil.Uint64(t.tx.Receipt.CumulativeGasUsed)
+	return &gasUsed
+}
+
+func (t *gqlTransaction) EffectiveGasPrice() *hexutil.Big {
+	if t.tx.Receipt == nil {
+		return nil
+	}
+	return hexBig(t.tx.Receipt.EffectiveGasPrice)
+}
+
+// BlobGasUsed is the blob gas of a mined blob transaction, fixed per blob.
+func (t *gqlTransaction) BlobGasUsed() *hexutil.Uint64 {
+	if t.tx.Receipt == nil || t.tx.Type != types.BlobTxType {
+		return nil
+	}
+	gasUsed := hexutil.Uint64(uint64(len(t.tx.BlobHashes)) * params.BlobTxBlobGasPerBlob)
+	return &gasUsed
+}
+
+// BlobGasPrice is the blob gas price of a mined blob transaction, set by the
+// excess blob gas of its block.
+func (t *gqlTransaction) BlobGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	if t.tx.Receipt == nil || t.tx.Type != types.BlobTxType {
+		return nil, nil
+	}
+	block, err := t.Block(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	header, err := block.headerField(ctx)
+	if err != nil || header.ExcessBlobGas == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(eip4844.CalcBlobFee(*header.ExcessBlobGas)), nil
+}
+
+func (t *gqlTransaction) CreatedContract(args struct{ Block *long }) *gqlAccount {
+	if t.tx.Receipt == nil || t.tx.Receipt.ContractAddress == "" {
+		return nil
+	}
+	return newGQLAccount(t.r, common.HexToAddress(t.tx.Receipt.ContractAddress), args.Block)
+}
+
+func (t *gqlTransaction) Logs() *[]*gqlLog {
+	if t.tx.Receipt == nil {
+		return nil
+	}
+	logs := make([]*gqlLog, len(t.tx.Receipt.Logs))
+	for i, log := range t.tx.Receipt.Logs {
+		logs[i] = &gqlLog{r: t.r, log: log, tx: t}
+	}
+	return &logs
+}
+
+func (t *gqlTransaction) R(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.signed(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	_, r, _ := tx.RawSignatureValues()
+	return hexutil.Big(*r), nil
+}
+
+func (t *gqlTransaction) S(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.signed(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	_, _, s := tx.RawSignatureValues()
+	return hexutil.Big(*s), nil
+}
+
+func (t *gqlTransaction) V(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.signed(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	v, _, _ := tx.RawSignatureValues()
+	return hexutil.Big(*v), nil
+}
+
+// YParity is the v of a typed transaction, null for a legacy one.
+func (t *gqlTransaction) YParity(ctx context.Context) (*hexutil.Big, error) {
+	if t.tx.Type == types.LegacyTxType {
+		return nil, nil
+	}
+	v, err := t.V(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (t *gqlTransaction) Type() *hexutil.Uint64 {
+	txType := hexutil.Uint64(t.tx.Type)
+	return &txType
+}
+
+func (t *gqlTransaction) AccessList() *[]*gqlAccessTuple {
+	if len(t.tx.AccessList) == 0 {
+		return nil
+	}
+	tuples := make([]*gqlAccessTuple, len(t.tx.AccessList))
+	for i, tuple := range t.tx.AccessList {
+		tuples[i] = &gqlAccessTuple{tuple: tuple}
+	}
+	return &tuples
+}
+
+func (t *gqlTransaction) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	tx, err := t.signed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.MarshalBinary()
+}
+
+// RawReceipt is the encoded receipt of a mined transaction, empty while
+// pending.
+func (t *gqlTransaction) RawReceipt() (hexutil.Bytes, error) {
+	if t.tx.Receipt == nil {
+		return hexutil.Bytes{}, nil
+	}
+	raw, err := json.Marshal(newRPCReceipt(t.tx))
+	if err != nil {
+		return nil, err
+	}
+	receipt := new(types.Receipt)
+	if err := receipt.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	return receipt.MarshalBinary()
+}
+
+func (t *gqlTransaction) BlobVersionedHashes() *[]common.Hash {
+	if len(t.tx.BlobHashes) == 0 {
+		return nil
+	}
+	hashes := make([]common.Hash, len(t.tx.BlobHashes))
+	for i, hash := range t.tx.BlobHashes {
+		hashes[i] = common.HexToHash(hash)
+	}
+	return &hashes
+}
+
+type gqlAccessTuple struct {
+	tuple *pb.AccessTuple
+}
+
+func (a *gqlAccessTuple) Address() common.Address {
+	return common.HexToAddress(a.tuple.Address)
+}
+
+func (a *gqlAccessTuple) StorageKeys() []common.Hash {
+	keys := make([]common.Hash, len(a.tuple.StorageKeys))
+	for i, key := range a.tuple.StorageKeys {
+		keys[i] = common.HexToHash(key)
+	}
+	return keys
+}
+
+// gqlLog is a log, with the transaction that emitted it when known.
+type gqlLog struct {
+	r   *gqlResolver
+	log *pb.Log
+	tx  *gqlTransaction
+}
+
+func (l *gqlLog) Index() hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+func (l *gqlLog) Account(args struct{ Block *long }) *gqlAccount {
+	return newGQLAccount(l.r, common.HexToAddress(l.log.Address), args.Block)
+}
+
+func (l *gqlLog) Topics() []common.Hash {
+	topics := make([]common.Hash, len(l.log.Topics))
+	for i, topic := range l.log.Topics {
+		topics[i] = common.HexToHash(topic)
+	}
+	return topics
+}
+
+func (l *gqlLog) Data() hexutil.Bytes {
+	data, _ := hexutil.Decode(l.log.Data)
+	return data
+}
+
+func (l *gqlLog) Transaction(ctx context.Context) (*gqlTransaction, error) {
+	if l.tx != nil {
+		return l.tx, nil
+	}
+	tx, err := l.r.transaction(ctx, l.log.TxHash)
+	if err == nil && tx == nil {
+		err = errors.New("transaction not found")
+	}
+	return tx, err
+}
+
+// gqlAccount is an account at a block, in the pending state when pending, or
+// at the latest block when neither is set. Its state is read from the
+// upstream endpoints.
+type gqlAccount struct {
+	r       *gqlResolver
+	address common.Address
+	block   *uint64
+	pending bool
+}
+
+func newGQLAccount(r *gqlResolver, address common.Address, block *long) *gqlAccount {
+	account := &gqlAccount{r: r, address: address}
+	if block != nil {
+		num := uint64(*block)
+		account.block = &num
+	}
+	return account
+}
+
+// call calls an upstream state method with the address and block of the
+// account around args.
+func (a *gqlAccount) call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	tag := "latest"
+	if a.pending {
+		tag = "pending"
+	} else if a.block != nil {
+		tag = hexutil.EncodeUint64(*a.block)
+	}
+	args = append(append([]interface{}{a.address}, args...), tag)
+	return a.r.call(ctx, result, method, args...)
+}
+
+func (a *gqlAccount) Address() common.Address {
+	return a.address
+}
+
+func (a *gqlAccount) Balance(ctx context.Context) (hexutil.Big, error) {
+	var balance hexutil.Big
+	err := a.call(ctx, &balance, "eth_getBalance")
+	return balance, err
+}
+
+func (a *gqlAccount) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	var nonce hexutil.Uint64
+	err := a.call(ctx, &nonce, "eth_getTransactionCount")
+	return nonce, err
+}
+
+func (a *gqlAccount) Code(ctx context.Context) (hexutil.Bytes, error) {
+	var code hexutil.Bytes
+	err := a.call(ctx, &code, "eth_getCode")
+	return code, err
+}
+
+func (a *gqlAccount) Storage(ctx context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	var value common.Hash
+	err := a.call(ctx, &value, "eth_getStorageAt", args.Slot)
+	return value, err
+}
+
+func bigOrZero(n *hexutil.Big) hexutil.Big {
+	if n == nil {
+		return hexutil.Big{}
+	}
+	return *n
+}
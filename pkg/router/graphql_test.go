@@ -0,0 +1,226 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"Kumazan/go-ethereum-server/pkg/model"
+	"Kumazan/go-ethereum-server/pkg/node"
+)
+
+// queryGraphQL runs the query on the GraphQL endpoint at url and decodes its
+// data into result.
+func queryGraphQL(t *testing.T, url, query string, result interface{}) {
+	t.Helper()
+	body, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Post(url+"/graphql", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var res struct {
+		Data   json.RawMessage
+		Errors []struct{ Message string }
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Errors) > 0 {
+		t.Fatalf("query failed: %v", res.Errors)
+	}
+	if err := json.Unmarshal(res.Data, result); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGraphQLEncodings(t *testing.T) {
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	block, receipts := testBlock(t, signer)
+	svc := &fakeService{blocks: map[uint64]*model.Block{}, txs: map[string]*model.Transaction{}}
+	svc.index(t, block, receipts, signer)
+	url := startRouter(t, svc, nil)
+
+	var res struct {
+		Block struct {
+			Hash              common.Hash
+			Miner             struct{ Address common.Address }
+			GasUsed           hexutil.Uint64
+			BaseFeePerGas     *hexutil.Big
+			NextBaseFeePerGas *hexutil.Big
+			StateRoot         common.Hash
+			LogsBloom         hexutil.Bytes
+			OmmerCount        hexutil.Uint64
+			Withdrawals       []struct{ Index, Amount hexutil.Uint64 }
+			RawHeader         hexutil.Bytes
+			Raw               hexutil.Bytes
+			Transactions      []struct {
+				V, R, S      hexutil.Big
+				EffectiveTip hexutil.Big
+				Raw          hexutil.Bytes
+				RawReceipt   hexutil.Bytes
+			}
+		}
+	}
+	queryGraphQL(t, url, `{ block(number: 7) {
+		hash miner { address } gasUsed baseFeePerGas nextBaseFeePerGas stateRoot logsBloom ommerCount
+		withdrawals { index amount } rawHeader raw
+		transactions { v r s effectiveTip raw rawReceipt }
+	} }`, &res)
+
+	got, header := res.Block, block.Header()
+	if got.Hash != block.Hash() || got.Miner.Address != header.Coinbase || uint64(got.GasUsed) != header.GasUsed ||
+		got.StateRoot != header.Root || !bytes.Equal(got.LogsBloom, header.Bloom.Bytes()) || got.OmmerCount != 0 {
+		t.Errorf("block = %+v, want the fields of %+v", got, header)
+	}
+	// the block is far below its gas target, the base fee drops by almost 1/8
+	if got.BaseFeePerGas.ToInt().Cmp(header.BaseFee) != 0 || got.NextBaseFeePerGas.ToInt().Int64() != 1752716667 {
+		t.Errorf("base fees = %v, %v, want %v and 1752716667", got.BaseFeePerGas, got.NextBaseFeePerGas, header.BaseFee)
+	}
+	if len(got.Withdrawals) != 1 || got.Withdrawals[0].Amount != 3 {
+		t.Errorf("withdrawals = %v, want %v", got.Withdrawals, block.Withdrawals())
+	}
+	if want, _ := rlp.EncodeToBytes(header); !bytes.Equal(got.RawHeader, want) {
+		t.Errorf("rawHeader = %x, want %x", got.RawHeader, want)
+	}
+	if want, _ := rlp.EncodeToBytes(block); !bytes.Equal(got.Raw, want) {
+		t.Errorf("raw = %x, want %x", got.Raw, want)
+	}
+	if len(got.Transactions) != len(block.Transactions()) {
+		t.Fatalf("%d transactions, want %d", len(got.Transactions), len(block.Transactions()))
+	}
+	for i, tx := range block.Transactions() {
+		gotTx := got.Transactions[i]
+		v, r, s := tx.RawSignatureValues()
+		if gotTx.V.ToInt().Cmp(v) != 0 || gotTx.R.ToInt().Cmp(r) != 0 || gotTx.S.ToInt().Cmp(s) != 0 {
+			t.Errorf("transaction %d signature = %v %v %v, want %v %v %v", i, gotTx.V, gotTx.R, gotTx.S, v, r, s)
+		}
+		if tip := gotTx.EffectiveTip.ToInt().Int64(); tip != 1e9 {
+			t.Errorf("transaction %d effectiveTip = %d, want %d", i, tip, int64(1e9))
+		}
+		if want, _ := tx.MarshalBinary(); !bytes.Equal(gotTx.Raw, want) {
+			t.Errorf("transaction %d raw = %x, want %x", i, gotTx.Raw, want)
+		}
+		if want, _ := receipts[i].MarshalBinary(); !bytes.Equal(gotTx.RawReceipt, want) {
+			t.Errorf("transaction %d rawReceipt = %x, want %x", i, gotTx.RawReceipt, want)
+		}
+	}
+}
+
+// graphqlUpstream answers the chain and pending state the index does not
+// hold.
+type graphqlUpstream struct {
+	pending *types.Transaction
+	from    common.Address
+	sent    *hexutil.Bytes
+}
+
+func (u *graphqlUpstream) ChainId() hexutil.Uint64 {
+	return 1
+}
+
+func (u *graphqlUpstream) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(3e9))
+}
+
+func (u *graphqlUpstream) Syncing() interface{} {
+	return map[string]hexutil.Uint64{"startingBlock": 1, "currentBlock": 5, "highestBlock": 9}
+}
+
+func (u *graphqlUpstream) GetTransactionByHash(hash common.Hash) (map[string]interface{}, error) {
+	if hash != u.pending.Hash() {
+		return nil, nil
+	}
+	raw, err := u.pending.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var tx map[string]interface{}
+	if err := json.Unmarshal(raw, &tx); err != nil {
+		return nil, err
+	}
+	tx["from"], tx["blockHash"] = u.from, nil
+	return tx, nil
+}
+
+func (u *graphqlUpstream) SendRawTransaction(data hexutil.Bytes) common.Hash {
+	u.sent = &data
+	return common.HexToHash("0x5e")
+}
+
+func TestGraphQLUpstream(t *testing.T) {
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	block, receipts := testBlock(t, signer)
+	pending := block.Transactions()[2]
+	from, err := types.Sender(signer, pending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := &fakeService{blocks: map[uint64]*model.Block{}, txs: map[string]*model.Transaction{}}
+
+	// the pending transaction is not indexed yet
+	up := &graphqlUpstream{pending: pending, from: from}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", up); err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	upstream, err := node.Dial([]string{ts.URL}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := startRouter(t, svc, upstream)
+
+	var res struct {
+		ChainID  hexutil.Big
+		GasPrice hexutil.Big
+		Syncing  struct{ CurrentBlock hexutil.Uint64 }
+		Pending  struct {
+			Hash  common.Hash
+			From  struct{ Address common.Address }
+			Block *struct{ Number hexutil.Uint64 }
+			Raw   hexutil.Bytes
+		}
+	}
+	queryGraphQL(t, url, `{ chainID gasPrice syncing { currentBlock }
+		pending: transaction(hash: "`+pending.Hash().String()+`") { hash from { address } block { number } raw }
+	}`, &res)
+	if res.ChainID.ToInt().Int64() != 1 || res.GasPrice.ToInt().Int64() != 3e9 || res.Syncing.CurrentBlock != 5 {
+		t.Errorf("chain state = %+v, want the upstream answers", res)
+	}
+	want, _ := pending.MarshalBinary()
+	if res.Pending.Hash != pending.Hash() || res.Pending.From.Address != from || res.Pending.Block != nil ||
+		!bytes.Equal(res.Pending.Raw, want) {
+		t.Errorf("pending transaction = %+v, want %s from %s", res.Pending, pending.Hash(), from)
+	}
+
+	var sent struct{ SendRawTransaction common.Hash }
+	queryGraphQL(t, url, `mutation { sendRawTransaction(data: "`+hexutil.Encode(want)+`") }`, &sent)
+	if sent.SendRawTransaction != common.HexToHash("0x5e") || up.sent == nil || !bytes.Equal(*up.sent, want) {
+		t.Errorf("sendRawTransaction = %s, sent %v", sent.SendRawTransaction, up.sent)
+	}
+
+	svc.index(t, block, receipts, signer)
+	var mined struct {
+		Transaction struct {
+			Block *struct{ Number hexutil.Uint64 }
+		}
+	}
+	queryGraphQL(t, url, `{ transaction(hash: "`+pending.Hash().String()+`") { block { number } } }`, &mined)
+	if mined.Transaction.Block == nil || uint64(mined.Transaction.Block.Number) != block.NumberU64() {
+		t.Errorf("mined transaction block = %v, want %d", mined.Transaction.Block, block.NumberU64())
+	}
+}
@@ -2,40 +2,96 @@ package router
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
+	"math/big"
 	"net/http"
+	"os"
 	"regexp"
 	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"Kumazan/go-ethereum-server/pb"
 	"Kumazan/go-ethereum-server/pkg/grpc"
 	"Kumazan/go-ethereum-server/pkg/model"
+	"Kumazan/go-ethereum-server/pkg/node"
 )
 
 type Handler struct {
 	*gin.Engine
 	ctx context.Context
 	ec  *grpc.EthereumClient
+	// upstream answers the JSON-RPC methods the index does not, nil when
+	// none is configured
+	upstream *node.Pool
+	schema   *graphql.Schema
 }
 
-func New(ec *grpc.EthereumClient) Handler {
+func New(ec *grpc.EthereumClient, upstream *node.Pool) Handler {
 	h := Handler{
-		Engine: gin.Default(),
-		ctx:    context.Background(),
-		ec:     ec,
+		Engine:   gin.Default(),
+		ctx:      context.Background(),
+		ec:       ec,
+		upstream: upstream,
+		schema:   newGraphQLSchema(ec, upstream),
 	}
 
 	h.GET("/blocks", h.listBlocks)
 	h.GET("/blocks/:id", h.getBlock)
 	h.GET("/transaction/:txHash", h.getTransaction)
+	h.GET("/transaction/:txHash/receipt", h.getReceipt)
+	h.GET("/transaction/:txHash/internal", h.getInternalTransactions)
+	h.GET("/logs", h.getLogs)
+	h.GET("/addresses/:address/transactions", h.getAddressTransactions)
+	h.GET("/tokens/:address/transfers", h.getTokenTransfers)
+	h.GET("/addresses/:address/token-transfers", h.getAddressTokenTransfers)
+	h.GET("/nfts/:address/:tokenId/owners", h.getNFTOwners)
+	h.GET("/addresses/:address/nfts", h.getNFTInventory)
+	h.GET("/ws/blocks", h.wsBlocks)
+	h.GET("/ws", h.wsSubscribe)
+	h.GET("/stream/blocks", h.streamBlocks)
+	h.POST("/rpc", h.serveRPC)
+	h.POST("/graphql", h.serveGraphQL)
+	h.GET("/api", h.serveEtherscan)
+
+	// admin endpoints are only served when a token is configured
+	if adminToken != "" {
+		admin := h.Group("/admin", requireAdmin)
+		admin.PUT("/abis/:address", h.setContractABI)
+		admin.POST("/signatures", h.importSignatures)
+	}
 
 	return h
 }
 
+var (
+	adminToken = os.Getenv("ADMIN_TOKEN")
+)
+
+func requireAdmin(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"message": "token is invalid",
+		})
+		return
+	}
+	c.Next()
+}
+
 func (h *Handler) listBlocks(c *gin.Context) {
+	if c.Query("from") != "" || c.Query("to") != "" {
+		h.listBlockRange(c)
+		return
+	}
+
 	qLimit := c.DefaultQuery("limit", "1")
 	limit, err := strconv.Atoi(qLimit)
 	if err != nil {
@@ -44,20 +100,77 @@ func (h *Handler) listBlocks(c *gin.Context) {
 		})
 		return
 	}
-	if limit < 0 || limit > 1024 {
+	if limit <= 0 || limit > 1024 {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"message": "limit is invalid",
 		})
 		return
 	}
 
-	req := &pb.ListLastestBlocksRequest{Limit: int32(limit)}
+	finality := c.DefaultQuery("finality", model.FinalityLatest)
+	if !model.IsFinality(finality) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "finality is invalid",
+		})
+		return
+	}
+
+	cursor := c.Query("cursor")
+	if _, err := model.DecodeCursor(cursor); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "cursor is invalid",
+		})
+		return
+	}
+
+	req := &pb.ListLastestBlocksRequest{Limit: int32(limit), Finality: finality, PageToken: cursor}
 	resp, err := h.ec.ListLastestBlocks(h.ctx, req)
 	if err != nil {
 		c.Status(http.StatusInternalServerError)
 		return
 	}
 
+	c.JSON(http.StatusOK, gin.H{
+		"blocks":      resp.Blocks,
+		"next_cursor": resp.NextPageToken,
+		"prev_cursor": resp.PrevPageToken,
+	})
+}
+
+// listBlockRange lists the blocks from the from block to the to block, newest
+// first.
+func (h *Handler) listBlockRange(c *gin.Context) {
+	req := &pb.ListBlocksRequest{}
+	var ok bool
+	if req.FromBlock, ok = parseBlockTag(c.DefaultQuery("from", "earliest")); !ok || req.FromBlock < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "from is invalid",
+		})
+		return
+	}
+	toBlock, ok := parseBlockTag(c.DefaultQuery("to", "latest"))
+	if !ok || (toBlock >= 0 && toBlock < req.FromBlock) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "to is invalid",
+		})
+		return
+	}
+	req.ToBlock = blockValue(toBlock)
+
+	req.Finality = c.DefaultQuery("finality", model.FinalityLatest)
+	if !model.IsFinality(req.Finality) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "finality is invalid",
+		})
+		return
+	}
+
+	resp, err := h.ec.ListBlocks(h.ctx, req)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"blocks": resp.Blocks,
 	})
@@ -65,6 +178,10 @@ func (h *Handler) listBlocks(c *gin.Context) {
 
 func (h *Handler) getBlock(c *gin.Context) {
 	pNum := c.Param("id")
+	if hashValidator.MatchString(pNum) {
+		h.getBlockByHash(c, common.HexToHash(pNum).String())
+		return
+	}
 	blockNum, err := strconv.Atoi(pNum)
 	if err != nil || blockNum < 0 {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -73,7 +190,21 @@ func (h *Handler) getBlock(c *gin.Context) {
 		return
 	}
 
-	req := &pb.GetBlockRequest{BlockNum: int64(blockNum)}
+	finality := c.DefaultQuery("finality", model.FinalityLatest)
+	if !model.IsFinality(finality) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "finality is invalid",
+		})
+		return
+	}
+
+	req := &pb.GetBlockRequest{BlockNum: int64(blockNum), Finality: finality}
+	if req.FullTransactions, req.Receipts, err = parseFull(c); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
 	resp, err := h.ec.GetBlock(h.ctx, req)
 	if err != nil {
 		status, ok := status.FromError(err)
@@ -87,7 +218,75 @@ func (h *Handler) getBlock(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, resp.Block)
+	c.JSON(http.StatusOK, newBlock(resp.Block, req.FullTransactions))
+}
+
+func (h *Handler) getBlockByHash(c *gin.Context, hash string) {
+	finality := c.DefaultQuery("finality", model.FinalityLatest)
+	if !model.IsFinality(finality) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "finality is invalid",
+		})
+		return
+	}
+	orphaned, err := strconv.ParseBool(c.DefaultQuery("orphaned", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "orphaned is invalid",
+		})
+		return
+	}
+
+	req := &pb.GetBlockByHashRequest{BlockHash: hash, Orphaned: orphaned, Finality: finality}
+	if req.FullTransactions, req.Receipts, err = parseFull(c); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	resp, err := h.ec.GetBlockByHash(h.ctx, req)
+	if err != nil {
+		status, ok := status.FromError(err)
+		if ok && status.Code() == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": status.Message(),
+			})
+			return
+		}
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, newBlock(resp.Block, req.FullTransactions))
+}
+
+// parseFull parses the full and receipts query parameters of a block.
+func parseFull(c *gin.Context) (full, receipts bool, err error) {
+	if full, err = strconv.ParseBool(c.DefaultQuery("full", "false")); err != nil {
+		return false, false, errors.New("full is invalid")
+	}
+	if receipts, err = strconv.ParseBool(c.DefaultQuery("receipts", "false")); err != nil {
+		return false, false, errors.New("receipts is invalid")
+	}
+	return full, receipts, nil
+}
+
+// fullBlock is a block with its transaction objects in place of their hashes.
+type fullBlock struct {
+	*pb.Block
+	FullTransactions []model.Transaction `json:"-"`
+	Transactions     []model.Transaction `json:"transactions"`
+}
+
+func newBlock(block *pb.Block, full bool) interface{} {
+	if !full {
+		return block
+	}
+	txs := make([]model.Transaction, len(block.FullTransactions))
+	for i, tx := range block.FullTransactions {
+		txs[i] = newTransaction(tx)
+	}
+	return fullBlock{Block: block, Transactions: txs}
 }
 
 var hashValidator = regexp.MustCompile(`^0x([A-Fa-f0-9]{64})$`)
@@ -101,7 +300,15 @@ func (h *Handler) getTransaction(c *gin.Context) {
 		return
 	}
 
-	req := &pb.GetTransactionRequest{TxHash: txHash}
+	finality := c.DefaultQuery("finality", model.FinalityLatest)
+	if !model.IsFinality(finality) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "finality is invalid",
+		})
+		return
+	}
+
+	req := &pb.GetTransactionRequest{TxHash: txHash, Finality: finality}
 	resp, err := h.ec.GetTransaction(h.ctx, req)
 	if err != nil {
 		status, ok := status.FromError(err)
@@ -115,21 +322,597 @@ func (h *Handler) getTransaction(c *gin.Context) {
 		return
 	}
 
-	tx := resp.Tx
+	c.JSON(http.StatusOK, newTransaction(resp.Tx))
+}
+
+func (h *Handler) getAddressTransactions(c *gin.Context) {
+	address := c.Param("address")
+	if !addressValidator.MatchString(address) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "address is invalid",
+		})
+		return
+	}
+	req := &pb.GetAddressTransactionsRequest{Address: common.HexToAddress(address).String()}
+
+	switch req.Direction = c.DefaultQuery("direction", model.DirectionBoth); req.Direction {
+	case model.DirectionIn, model.DirectionOut, model.DirectionBoth:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "direction is invalid",
+		})
+		return
+	}
+
+	fromBlock, ok := parseBlockTag(c.DefaultQuery("fromBlock", "earliest"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "fromBlock is invalid",
+		})
+		return
+	}
+	toBlock, ok := parseBlockTag(c.DefaultQuery("toBlock", "latest"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "toBlock is invalid",
+		})
+		return
+	}
+	req.FromBlock, req.ToBlock = blockValue(fromBlock), blockValue(toBlock)
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if err != nil || limit <= 0 || limit > maxTxsLimit {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "limit is invalid",
+		})
+		return
+	}
+	req.Limit = int32(limit)
+
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "offset is invalid",
+		})
+		return
+	}
+	req.Offset = int32(offset)
+
+	req.PageToken = c.Query("cursor")
+	if _, err := model.DecodeCursor(req.PageToken); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "cursor is invalid",
+		})
+		return
+	}
+
+	resp, err := h.ec.GetAddressTransactions(h.ctx, req)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	txs := make([]model.Transaction, len(resp.Txs))
+	for i, tx := range resp.Txs {
+		txs[i] = newTransaction(tx)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"transactions": txs,
+		"next_cursor":  resp.NextPageToken,
+		"prev_cursor":  resp.PrevPageToken,
+	})
+}
+
+func newTransaction(tx *pb.Transaction) model.Transaction {
 	logs := make([]model.Log, len(tx.Logs))
 	for i, log := range tx.Logs {
-		logs[i] = model.Log{
-			Index: uint(log.Index),
-			Data:  log.Data,
+		logs[i] = newLog(log)
+	}
+	var accessList model.AccessList
+	for _, tuple := range tx.AccessList {
+		accessList = append(accessList, model.AccessTuple{
+			Address:     tuple.Address,
+			StorageKeys: tuple.StorageKeys,
+		})
+	}
+	var receipt *model.Receipt
+	if tx.Receipt != nil {
+		receipt = newReceipt(tx.Receipt)
+	}
+	return model.Transaction{
+		TxHash:               tx.TxHash,
+		BlockNum:             uint64(tx.BlockNum),
+		Type:                 uint8(tx.Type),
+		FromAddr:             tx.FromAddr,
+		ToAddr:               tx.ToAddr,
+		Nonce:                uint64(tx.Nonce),
+		Gas:                  uint64(tx.Gas),
+		GasPrice:             tx.GasPrice,
+		MaxFeePerGas:         tx.MaxFeePerGas,
+		MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+		MaxFeePerBlobGas:     tx.MaxFeePerBlobGas,
+		Data:                 tx.Data,
+		Value:                tx.Value,
+		AccessList:           accessList,
+		BlobHashes:           tx.BlobHashes,
+		ChainID:              tx.ChainId,
+		V:                    tx.V,
+		R:                    tx.R,
+		S:                    tx.S,
+		Finality:             tx.Finality,
+		Decoded:              newDecoded(tx.Decoded),
+		Candidates:           newCandidates(tx.Candidates),
+		Logs:                 logs,
+		Receipt:              receipt,
+	}
+}
+
+func (h *Handler) getReceipt(c *gin.Context) {
+	txHash := c.Param("txHash")
+	if !hashValidator.MatchString(txHash) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "txHash is invalid",
+		})
+		return
+	}
+
+	req := &pb.GetReceiptRequest{TxHash: txHash}
+	resp, err := h.ec.GetReceipt(h.ctx, req)
+	if err != nil {
+		status, ok := status.FromError(err)
+		if ok && status.Code() == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": status.Message(),
+			})
+			return
 		}
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, newReceipt(resp.Receipt))
+}
+
+func newReceipt(receipt *pb.Receipt) *model.Receipt {
+	logs := make([]model.Log, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		logs[i] = newLog(log)
+	}
+	return &model.Receipt{
+		TxHash:            receipt.TxHash,
+		BlockNum:          uint64(receipt.BlockNum),
+		BlockHash:         receipt.BlockHash,
+		TxIndex:           uint(receipt.TxIndex),
+		Type:              uint8(receipt.Type),
+		Status:            uint64(receipt.Status),
+		CumulativeGasUsed: uint64(receipt.CumulativeGasUsed),
+		GasUsed:           uint64(receipt.GasUsed),
+		EffectiveGasPrice: receipt.EffectiveGasPrice,
+		ContractAddress:   receipt.ContractAddress,
+		Logs:              logs,
+	}
+}
+
+var addressValidator = regexp.MustCompile(`^0x([A-Fa-f0-9]{40})$`)
+
+const (
+	maxLogsLimit = 10000
+	maxTxsLimit  = 1000
+)
+
+func (h *Handler) getInternalTransactions(c *gin.Context) {
+	txHash := c.Param("txHash")
+	if !hashValidator.MatchString(txHash) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "txHash is invalid",
+		})
+		return
 	}
-	c.JSON(http.StatusOK, model.Transaction{
-		TxHash:   tx.TxHash,
-		FromAddr: tx.FromAddr,
-		ToAddr:   tx.ToAddr,
-		Nonce:    uint64(tx.Nonce),
-		Data:     tx.Data,
-		Value:    tx.Value,
-		Logs:     logs,
+
+	req := &pb.GetInternalTransactionsRequest{TxHash: txHash}
+	resp, err := h.ec.GetInternalTransactions(h.ctx, req)
+	if err != nil {
+		status, ok := status.FromError(err)
+		if ok && status.Code() == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": status.Message(),
+			})
+			return
+		}
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	txs := make([]model.InternalTransaction, len(resp.InternalTransactions))
+	for i, tx := range resp.InternalTransactions {
+		txs[i] = model.InternalTransaction{
+			TxHash:   tx.TxHash,
+			Index:    uint(tx.Index),
+			BlockNum: uint64(tx.BlockNum),
+			Type:     tx.Type,
+			FromAddr: tx.FromAddr,
+			ToAddr:   tx.ToAddr,
+			Value:    tx.Value,
+			Gas:      uint64(tx.Gas),
+			GasUsed:  uint64(tx.GasUsed),
+			Depth:    uint(tx.Depth),
+			Error:    tx.Error,
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"internal_transactions": txs,
+	})
+}
+
+func (h *Handler) getLogs(c *gin.Context) {
+	req := &pb.GetLogsRequest{}
+
+	if qAddress := c.Query("address"); qAddress != "" {
+		for _, address := range strings.Split(qAddress, ",") {
+			if !addressValidator.MatchString(address) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"message": "address is invalid",
+				})
+				return
+			}
+			req.Addresses = append(req.Addresses, common.HexToAddress(address).String())
+		}
+	}
+
+	if qTopics := c.Query("topics"); qTopics != "" {
+		positions := strings.Split(qTopics, ",")
+		if len(positions) > 4 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "topics is invalid",
+			})
+			return
+		}
+		req.Topics = make([]*pb.TopicFilter, len(positions))
+		for i, position := range positions {
+			req.Topics[i] = &pb.TopicFilter{}
+			if position == "" {
+				continue
+			}
+			for _, topic := range strings.Split(position, "|") {
+				if !hashValidator.MatchString(topic) {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"message": "topics is invalid",
+					})
+					return
+				}
+				req.Topics[i].Topics = append(req.Topics[i].Topics, strings.ToLower(topic))
+			}
+		}
+	}
+
+	fromBlock, ok := parseBlockTag(c.DefaultQuery("fromBlock", "latest"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "fromBlock is invalid",
+		})
+		return
+	}
+	toBlock, ok := parseBlockTag(c.DefaultQuery("toBlock", "latest"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "toBlock is invalid",
+		})
+		return
+	}
+	req.FromBlock, req.ToBlock = blockValue(fromBlock), blockValue(toBlock)
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "1000"))
+	if err != nil || limit <= 0 || limit > maxLogsLimit {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "limit is invalid",
+		})
+		return
+	}
+	req.Limit = int32(limit)
+
+	resp, err := h.ec.GetLogs(h.ctx, req)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	logs := make([]model.Log, len(resp.Logs))
+	for i, log := range resp.Logs {
+		logs[i] = newLog(log)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"logs": logs,
+	})
+}
+
+func (h *Handler) getTokenTransfers(c *gin.Context) {
+	token := c.Param("address")
+	if !addressValidator.MatchString(token) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "address is invalid",
+		})
+		return
+	}
+
+	req := &pb.GetTokenTransfersRequest{Token: common.HexToAddress(token).String()}
+	h.listTokenTransfers(c, req)
+}
+
+func (h *Handler) getAddressTokenTransfers(c *gin.Context) {
+	address := c.Param("address")
+	if !addressValidator.MatchString(address) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "address is invalid",
+		})
+		return
+	}
+	req := &pb.GetTokenTransfersRequest{Address: common.HexToAddress(address).String()}
+
+	if token := c.Query("token"); token != "" {
+		if !addressValidator.MatchString(token) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "token is invalid",
+			})
+			return
+		}
+		req.Token = common.HexToAddress(token).String()
+	}
+	h.listTokenTransfers(c, req)
+}
+
+// listTokenTransfers reads the block range and limit of req from the query
+// and responds with the matching token transfers.
+func (h *Handler) listTokenTransfers(c *gin.Context, req *pb.GetTokenTransfersRequest) {
+	fromBlock, ok := parseBlockTag(c.DefaultQuery("fromBlock", "earliest"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "fromBlock is invalid",
+		})
+		return
+	}
+	toBlock, ok := parseBlockTag(c.DefaultQuery("toBlock", "latest"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "toBlock is invalid",
+		})
+		return
+	}
+	req.FromBlock, req.ToBlock = blockValue(fromBlock), blockValue(toBlock)
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "1000"))
+	if err != nil || limit <= 0 || limit > maxLogsLimit {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "limit is invalid",
+		})
+		return
+	}
+	req.Limit = int32(limit)
+
+	resp, err := h.ec.GetTokenTransfers(h.ctx, req)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	transfers := make([]model.TokenTransfer, len(resp.Transfers))
+	for i, transfer := range resp.Transfers {
+		transfers[i] = model.TokenTransfer{
+			TxHash:   transfer.TxHash,
+			LogIndex: uint(transfer.LogIndex),
+			BlockNum: uint64(transfer.BlockNum),
+			Token:    transfer.Token,
+			FromAddr: transfer.FromAddr,
+			ToAddr:   transfer.ToAddr,
+			Amount:   transfer.Amount,
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"transfers": transfers,
 	})
 }
+
+func (h *Handler) getNFTOwners(c *gin.Context) {
+	token := c.Param("address")
+	if !addressValidator.MatchString(token) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "address is invalid",
+		})
+		return
+	}
+	tokenID, ok := new(big.Int).SetString(c.Param("tokenId"), 10)
+	if !ok || tokenID.Sign() < 0 || tokenID.BitLen() > 256 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "tokenId is invalid",
+		})
+		return
+	}
+
+	req := &pb.GetNFTOwnersRequest{
+		Token:   common.HexToAddress(token).String(),
+		TokenId: tokenID.String(),
+	}
+	resp, err := h.ec.GetNFTOwners(h.ctx, req)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"owners": newHoldings(resp.Holdings),
+	})
+}
+
+func (h *Handler) getNFTInventory(c *gin.Context) {
+	owner := c.Param("address")
+	if !addressValidator.MatchString(owner) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "address is invalid",
+		})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "1000"))
+	if err != nil || limit <= 0 || limit > maxLogsLimit {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "limit is invalid",
+		})
+		return
+	}
+
+	req := &pb.GetNFTInventoryRequest{
+		Owner: common.HexToAddress(owner).String(),
+		Limit: int32(limit),
+	}
+	resp, err := h.ec.GetNFTInventory(h.ctx, req)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"nfts": newHoldings(resp.Holdings),
+	})
+}
+
+func (h *Handler) setContractABI(c *gin.Context) {
+	address := c.Param("address")
+	if !addressValidator.MatchString(address) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "address is invalid",
+		})
+		return
+	}
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "abi is invalid",
+		})
+		return
+	}
+
+	req := &pb.SetContractABIRequest{
+		Address: common.HexToAddress(address).String(),
+		Abi:     string(body),
+	}
+	_, err = h.ec.SetContractABI(h.ctx, req)
+	if err != nil {
+		status, ok := status.FromError(err)
+		if ok && status.Code() == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": status.Message(),
+			})
+			return
+		}
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Handler) importSignatures(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "signatures is invalid",
+		})
+		return
+	}
+
+	req := &pb.ImportSignaturesRequest{Signatures: string(body)}
+	resp, err := h.ec.ImportSignatures(h.ctx, req)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count": resp.Count,
+	})
+}
+
+// parseBlockTag parses a decimal or hex block number, "earliest" or
+// "latest". The latest block is returned as -1.
+func parseBlockTag(tag string) (int64, bool) {
+	switch tag {
+	case "latest":
+		return -1, true
+	case "earliest":
+		return 0, true
+	}
+	var num int64
+	var err error
+	if strings.HasPrefix(tag, "0x") {
+		num, err = strconv.ParseInt(tag[2:], 16, 64)
+	} else {
+		num, err = strconv.ParseInt(tag, 10, 64)
+	}
+	if err != nil || num < 0 {
+		return 0, false
+	}
+	return num, true
+}
+
+// blockValue returns a block number parsed by parseBlockTag as an optional
+// field, unset for the latest block.
+func blockValue(num int64) *wrapperspb.UInt64Value {
+	if num < 0 {
+		return nil
+	}
+	return wrapperspb.UInt64(uint64(num))
+}
+
+func newLog(log *pb.Log) model.Log {
+	return model.Log{
+		Address:    log.Address,
+		Topics:     log.Topics,
+		Data:       log.Data,
+		BlockNum:   uint64(log.BlockNum),
+		BlockHash:  log.BlockHash,
+		TxHash:     log.TxHash,
+		TxIndex:    uint(log.TxIndex),
+		Index:      uint(log.Index),
+		Removed:    log.Removed,
+		Decoded:    newDecoded(log.Decoded),
+		Candidates: newCandidates(log.Candidates),
+	}
+}
+
+func newCandidates(candidates []*pb.Decoded) []*model.Decoded {
+	var res []*model.Decoded
+	for _, candidate := range candidates {
+		res = append(res, newDecoded(candidate))
+	}
+	return res
+}
+
+func newDecoded(decoded *pb.Decoded) *model.Decoded {
+	if decoded == nil {
+		return nil
+	}
+	params := make([]model.DecodedParam, len(decoded.Params))
+	for i, param := range decoded.Params {
+		params[i] = model.DecodedParam{
+			Name:  param.Name,
+			Type:  param.Type,
+			Value: param.Value,
+		}
+	}
+	return &model.Decoded{
+		Name:      decoded.Name,
+		Signature: decoded.Signature,
+		Params:    params,
+	}
+}
+
+func newHoldings(holdings []*pb.NFTHolding) []model.NFTHolding {
+	res := make([]model.NFTHolding, len(holdings))
+	for i, holding := range holdings {
+		res[i] = model.NFTHolding{
+			Token:   holding.Token,
+			TokenID: holding.TokenId,
+			Owner:   holding.Owner,
+			Amount:  holding.Amount,
+		}
+	}
+	return res
+}
@@ -0,0 +1,32 @@
+package router
+
+import "testing"
+
+func TestParseBlockTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want int64
+		ok   bool
+	}{
+		{"latest", -1, true},
+		{"earliest", 0, true},
+		{"0", 0, true},
+		{"1234", 1234, true},
+		{"0x4d2", 1234, true},
+		{"0x", 0, false},
+		{"-1", 0, false},
+		{"0x-1", 0, false},
+		{"pending", 0, false},
+		{"", 0, false},
+		{"12a", 0, false},
+		{"0x8000000000000000", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, ok := parseBlockTag(tt.tag)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("parseBlockTag(%q) = %d, %v, want %d, %v", tt.tag, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,703 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"Kumazan/go-ethereum-server/pb"
+	"Kumazan/go-ethereum-server/pkg/grpc"
+	"Kumazan/go-ethereum-server/pkg/model"
+)
+
+// JSON-RPC error codes
+const (
+	errCodeParse          = -32700
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+	errCodeInvalidParams  = -32602
+	errCodeInternal       = -32603
+	errCodeServer         = -32000
+	errCodeLimitExceeded  = -32005
+)
+
+const maxBatchSize = 100
+
+type rpcRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type rpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+func rpcResponse(id json.RawMessage, result interface{}) gin.H {
+	return gin.H{"jsonrpc": "2.0", "id": id, "result": result}
+}
+
+func rpcErrorResponse(id json.RawMessage, code int, message string) gin.H {
+	return gin.H{"jsonrpc": "2.0", "id": id, "error": rpcError{Code: code, Message: message}}
+}
+
+// serveRPC answers a JSON-RPC request, or a batch of them, the way an Ethereum
+// node would. The read methods below are answered from the index, the other
+// methods of the eth, net and web3 namespaces are proxied to the upstream
+// endpoints.
+func (h *Handler) serveRPC(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusOK, rpcErrorResponse(nil, errCodeParse, "parse error"))
+		return
+	}
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || body[0] != '[' {
+		c.JSON(http.StatusOK, h.handleRPC(c.Request.Context(), body))
+		return
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(body, &batch); err != nil {
+		c.JSON(http.StatusOK, rpcErrorResponse(nil, errCodeParse, "parse error"))
+		return
+	}
+	if len(batch) == 0 {
+		c.JSON(http.StatusOK, rpcErrorResponse(nil, errCodeInvalidRequest, "empty batch"))
+		return
+	}
+	if len(batch) > maxBatchSize {
+		c.JSON(http.StatusOK, rpcErrorResponse(nil, errCodeLimitExceeded, "batch is too large"))
+		return
+	}
+
+	res := make([]gin.H, len(batch))
+	var wg sync.WaitGroup
+	for i, msg := range batch {
+		wg.Add(1)
+		go func(i int, msg json.RawMessage) {
+			defer wg.Done()
+			res[i] = h.handleRPC(c.Request.Context(), msg)
+		}(i, msg)
+	}
+	wg.Wait()
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *Handler) handleRPC(ctx context.Context, msg json.RawMessage) gin.H {
+	var req rpcRequest
+	if err := json.Unmarshal(msg, &req); err != nil {
+		if _, ok := err.(*json.SyntaxError); ok {
+			return rpcErrorResponse(nil, errCodeParse, "parse error")
+		}
+		return rpcErrorResponse(req.ID, errCodeInvalidRequest, "invalid request")
+	}
+	if req.Method == "" {
+		return rpcErrorResponse(req.ID, errCodeInvalidRequest, "invalid request")
+	}
+
+	result, err := h.call(ctx, &req)
+	if err != nil {
+		return gin.H{"jsonrpc": "2.0", "id": req.ID, "error": err}
+	}
+	return rpcResponse(req.ID, result)
+}
+
+// call answers the request from the index. A request that the index fails to
+// answer is proxied, so a call only fails when the upstream endpoints fail too.
+func (h *Handler) call(ctx context.Context, req *rpcRequest) (interface{}, *rpcError) {
+	var result interface{}
+	var err error
+	switch req.Method {
+	case "eth_blockNumber":
+		result, err = h.ethBlockNumber(ctx)
+	case "eth_getBlockByNumber":
+		result, err = h.ethGetBlockByNumber(ctx, req.Params)
+	case "eth_getBlockByHash":
+		result, err = h.ethGetBlockByHash(ctx, req.Params)
+	case "eth_getTransactionByHash":
+		result, err = h.ethGetTransactionByHash(ctx, req.Params)
+	case "eth_getTransactionReceipt":
+		result, err = h.ethGetTransactionReceipt(ctx, req.Params)
+	case "eth_getLogs":
+		result, err = h.ethGetLogs(ctx, req.Params)
+	case "eth_subscribe", "eth_unsubscribe":
+		// notifications need a connection, which /ws serves
+		return nil, &rpcError{Code: errCodeMethodNotFound, Message: "notifications not supported"}
+	default:
+		return h.proxy(ctx, req)
+	}
+
+	var rpcErr *rpcError
+	if errors.As(err, &rpcErr) {
+		return nil, rpcErr
+	}
+	if err != nil {
+		if h.upstream != nil {
+			return h.proxy(ctx, req)
+		}
+		return nil, &rpcError{Code: errCodeInternal, Message: "internal error"}
+	}
+	return result, nil
+}
+
+// proxy forwards the request to the upstream endpoints. Only the eth, net and
+// web3 namespaces are forwarded, as the others may administer the node.
+func (h *Handler) proxy(ctx context.Context, req *rpcRequest) (interface{}, *rpcError) {
+	namespace := strings.SplitN(req.Method, "_", 2)[0]
+	if h.upstream == nil || (namespace != "eth" && namespace != "net" && namespace != "web3") {
+		return nil, &rpcError{Code: errCodeMethodNotFound, Message: "method not found"}
+	}
+
+	args := make([]interface{}, len(req.Params))
+	for i, param := range req.Params {
+		args[i] = param
+	}
+	var result json.RawMessage
+	if err := h.upstream.CallContext(ctx, &result, req.Method, args...); err != nil {
+		var upstreamErr rpc.Error
+		if !errors.As(err, &upstreamErr) {
+			return nil, &rpcError{Code: errCodeInternal, Message: "upstream request failed"}
+		}
+		rpcErr := &rpcError{Code: upstreamErr.ErrorCode(), Message: upstreamErr.Error()}
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) {
+			rpcErr.Data = dataErr.ErrorData()
+		}
+		return nil, rpcErr
+	}
+	return result, nil
+}
+
+// parseParams parses the positional params into args, of which the first
+// required ones must be given.
+func parseParams(params []json.RawMessage, required int, args ...interface{}) error {
+	if len(params) < required || len(params) > len(args) {
+		return &rpcError{Code: errCodeInvalidParams, Message: "wrong number of params"}
+	}
+	for i, param := range params {
+		if err := json.Unmarshal(param, args[i]); err != nil {
+			return &rpcError{Code: errCodeInvalidParams, Message: "invalid argument " + strconv.Itoa(i)}
+		}
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+func (h *Handler) ethBlockNumber(ctx context.Context) (interface{}, error) {
+	num, err := headNumber(ctx, h.ec, model.FinalityLatest)
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Uint64(num), nil
+}
+
+// headNumber returns the number of the newest indexed block of the finality.
+func headNumber(ctx context.Context, ec *grpc.EthereumClient, finality string) (uint64, error) {
+	resp, err := ec.ListLastestBlocks(ctx, &pb.ListLastestBlocksRequest{Limit: 1, Finality: finality})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Blocks) == 0 {
+		return 0, errors.New("no block indexed")
+	}
+	return uint64(resp.Blocks[0].BlockNum), nil
+}
+
+// blockNumber resolves a block tag or hex block number. The index holds no
+// pending block, so pending is the latest block.
+func (h *Handler) blockNumber(ctx context.Context, tag string) (uint64, error) {
+	switch tag {
+	case "", "latest", "pending":
+		return headNumber(ctx, h.ec, model.FinalityLatest)
+	case "safe":
+		return headNumber(ctx, h.ec, model.FinalitySafe)
+	case "finalized":
+		return headNumber(ctx, h.ec, model.FinalityFinalized)
+	case "earliest":
+		return 0, nil
+	}
+	num, err := hexutil.DecodeUint64(tag)
+	if err != nil {
+		return 0, &rpcError{Code: errCodeInvalidParams, Message: "block number is invalid"}
+	}
+	return num, nil
+}
+
+func (h *Handler) ethGetBlockByNumber(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var tag string
+	var full bool
+	if err := parseParams(params, 2, &tag, &full); err != nil {
+		return nil, err
+	}
+	num, err := h.blockNumber(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.GetBlockRequest{BlockNum: int64(num), FullTransactions: full, Receipts: full}
+	resp, err := h.ec.GetBlock(ctx, req)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newRPCBlock(resp.Block, full)
+}
+
+func (h *Handler) ethGetBlockByHash(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var hash string
+	var full bool
+	if err := parseParams(params, 2, &hash, &full); err != nil {
+		return nil, err
+	}
+	if !hashValidator.MatchString(hash) {
+		return nil, &rpcError{Code: errCodeInvalidParams, Message: "block hash is invalid"}
+	}
+
+	req := &pb.GetBlockByHashRequest{
+		BlockHash:        common.HexToHash(hash).String(),
+		FullTransactions: full,
+		Receipts:         full,
+	}
+	resp, err := h.ec.GetBlockByHash(ctx, req)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newRPCBlock(resp.Block, full)
+}
+
+// transactionWithReceipt returns the transaction with its receipt, or nil when
+// it is not found.
+func (h *Handler) transactionWithReceipt(ctx context.Context, params []json.RawMessage) (*pb.Transaction, error) {
+	var hash string
+	if err := parseParams(params, 1, &hash); err != nil {
+		return nil, err
+	}
+	if !hashValidator.MatchString(hash) {
+		return nil, &rpcError{Code: errCodeInvalidParams, Message: "transaction hash is invalid"}
+	}
+
+	req := &pb.GetTransactionRequest{TxHash: common.HexToHash(hash).String(), Receipt: true}
+	resp, err := h.ec.GetTransaction(ctx, req)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tx, nil
+}
+
+func (h *Handler) ethGetTransactionByHash(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	tx, err := h.transactionWithReceipt(ctx, params)
+	if tx == nil || err != nil {
+		return nil, err
+	}
+	return newRPCTransaction(tx, tx.Receipt.BlockHash, uint64(tx.Receipt.TxIndex))
+}
+
+func (h *Handler) ethGetTransactionReceipt(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	tx, err := h.transactionWithReceipt(ctx, params)
+	if tx == nil || err != nil {
+		return nil, err
+	}
+	return newRPCReceipt(tx), nil
+}
+
+func (h *Handler) ethGetLogs(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var raw json.RawMessage
+	var filter struct {
+		FromBlock string `json:"fromBlock"`
+		ToBlock   string `json:"toBlock"`
+		BlockHash string `json:"blockHash"`
+	}
+	if err := parseParams(params, 1, &raw); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &filter); err != nil {
+		return nil, &rpcError{Code: errCodeInvalidParams, Message: "filter is invalid"}
+	}
+	// the address and topics are filtered as by a logs subscription
+	sub, rpcErr := parseLogsFilter(raw)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	req := &pb.GetLogsRequest{Addresses: sub.Addresses, Topics: sub.Topics, Limit: maxLogsLimit + 1}
+
+	if filter.BlockHash != "" {
+		if filter.FromBlock != "" || filter.ToBlock != "" || !hashValidator.MatchString(filter.BlockHash) {
+			return nil, &rpcError{Code: errCodeInvalidParams, Message: "block hash is invalid"}
+		}
+		resp, err := h.ec.GetBlockByHash(ctx, &pb.GetBlockByHashRequest{BlockHash: common.HexToHash(filter.BlockHash).String()})
+		if isNotFound(err) {
+			return nil, &rpcError{Code: errCodeServer, Message: "unknown block"}
+		}
+		if err != nil {
+			return nil, err
+		}
+		num := wrapperspb.UInt64(uint64(resp.Block.BlockNum))
+		req.FromBlock, req.ToBlock = num, num
+	} else {
+		fromBlock, err := h.blockNumber(ctx, filter.FromBlock)
+		if err != nil {
+			return nil, err
+		}
+		toBlock, err := h.blockNumber(ctx, filter.ToBlock)
+		if err != nil {
+			return nil, err
+		}
+		if fromBlock > toBlock {
+			return nil, &rpcError{Code: errCodeInvalidParams, Message: "invalid block range params"}
+		}
+		req.FromBlock, req.ToBlock = wrapperspb.UInt64(fromBlock), wrapperspb.UInt64(toBlock)
+	}
+
+	resp, err := h.ec.GetLogs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Logs) > maxLogsLimit {
+		return nil, &rpcError{Code: errCodeLimitExceeded, Message: "query returned more than 10000 results"}
+	}
+	logs := make([]rpcLog, len(resp.Logs))
+	for i, log := range resp.Logs {
+		logs[i] = newRPCLog(log)
+	}
+	return logs, nil
+}
+
+// errIncomplete fails a request for an object stored before all of its fields
+// were indexed, for the request to be proxied.
+var errIncomplete = errors.New("object is not fully indexed")
+
+// rpcHeader is a block header as sent by a newHeads subscription. The total
+// difficulty is not indexed, and left out.
+type rpcHeader struct {
+	Number                hexutil.Uint64  `json:"number"`
+	Hash                  string          `json:"hash"`
+	ParentHash            string          `json:"parentHash"`
+	Nonce                 string          `json:"nonce"`
+	MixHash               string          `json:"mixHash"`
+	Sha3Uncles            string          `json:"sha3Uncles"`
+	LogsBloom             string          `json:"logsBloom"`
+	StateRoot             string          `json:"stateRoot"`
+	Miner                 string          `json:"miner"`
+	Difficulty            *hexutil.Big    `json:"difficulty"`
+	ExtraData             string          `json:"extraData"`
+	GasLimit              hexutil.Uint64  `json:"gasLimit"`
+	GasUsed               hexutil.Uint64  `json:"gasUsed"`
+	Timestamp             hexutil.Uint64  `json:"timestamp"`
+	TransactionsRoot      string          `json:"transactionsRoot"`
+	ReceiptsRoot          string          `json:"receiptsRoot"`
+	BaseFeePerGas         *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+	WithdrawalsRoot       *string         `json:"withdrawalsRoot,omitempty"`
+	BlobGasUsed           *hexutil.Uint64 `json:"blobGasUsed,omitempty"`
+	ExcessBlobGas         *hexutil.Uint64 `json:"excessBlobGas,omitempty"`
+	ParentBeaconBlockRoot *string         `json:"parentBeaconBlockRoot,omitempty"`
+}
+
+// newRPCHeader converts the header of the block, failing with errIncomplete
+// when it is not indexed.
+func newRPCHeader(block *pb.Block) (rpcHeader, error) {
+	if block.StateRoot == "" {
+		return rpcHeader{}, errIncomplete
+	}
+	res := rpcHeader{
+		Number:                hexutil.Uint64(block.BlockNum),
+		Hash:                  block.BlockHash,
+		ParentHash:            block.ParentHash,
+		Nonce:                 block.Nonce,
+		MixHash:               block.MixHash,
+		Sha3Uncles:            block.UnclesHash,
+		LogsBloom:             block.LogsBloom,
+		StateRoot:             block.StateRoot,
+		Miner:                 block.Miner,
+		Difficulty:            hexBig(block.Difficulty),
+		ExtraData:             block.ExtraData,
+		GasLimit:              hexutil.Uint64(block.GasLimit),
+		GasUsed:               hexutil.Uint64(block.GasUsed),
+		Timestamp:             hexutil.Uint64(block.BlockTime),
+		TransactionsRoot:      block.TransactionsRoot,
+		ReceiptsRoot:          block.ReceiptsRoot,
+		BaseFeePerGas:         hexBig(block.BaseFee),
+		WithdrawalsRoot:       optional(block.WithdrawalsRoot),
+		ParentBeaconBlockRoot: optional(block.ParentBeaconRoot),
+	}
+	if block.BlobGasUsed != nil {
+		res.BlobGasUsed = (*hexutil.Uint64)(&block.BlobGasUsed.Value)
+	}
+	if block.ExcessBlobGas != nil {
+		res.ExcessBlobGas = (*hexutil.Uint64)(&block.ExcessBlobGas.Value)
+	}
+	return res, nil
+}
+
+// rpcBlock is a block as returned by eth_getBlockByNumber.
+type rpcBlock struct {
+	rpcHeader
+	Size         hexutil.Uint64     `json:"size"`
+	Transactions interface{}        `json:"transactions"`
+	Uncles       []string           `json:"uncles"`
+	Withdrawals  *types.Withdrawals `json:"withdrawals,omitempty"`
+}
+
+// newRPCBlock converts the block, failing with errIncomplete when the header
+// or the signature of a transaction is not indexed.
+func newRPCBlock(block *pb.Block, full bool) (rpcBlock, error) {
+	header, err := newRPCHeader(block)
+	if err != nil {
+		return rpcBlock{}, err
+	}
+	res := rpcBlock{
+		rpcHeader: header,
+		Size:      hexutil.Uint64(block.Size),
+		Uncles:    block.Uncles,
+	}
+	if res.Uncles == nil {
+		res.Uncles = []string{}
+	}
+	if block.WithdrawalsRoot != "" {
+		withdrawals := make(types.Withdrawals, len(block.Withdrawals))
+		for i, w := range block.Withdrawals {
+			withdrawals[i] = &types.Withdrawal{
+				Index:     uint64(w.Index),
+				Validator: uint64(w.Validator),
+				Address:   common.HexToAddress(w.Address),
+				Amount:    uint64(w.Amount),
+			}
+		}
+		res.Withdrawals = &withdrawals
+	}
+	if !full {
+		txs := block.Transactions
+		if txs == nil {
+			txs = []string{}
+		}
+		res.Transactions = txs
+		return res, nil
+	}
+	txs := make([]rpcTransaction, len(block.FullTransactions))
+	for i, tx := range block.FullTransactions {
+		var err error
+		if txs[i], err = newRPCTransaction(tx, block.BlockHash, uint64(i)); err != nil {
+			return rpcBlock{}, err
+		}
+	}
+	res.Transactions = txs
+	return res, nil
+}
+
+// rpcTransaction is a transaction as returned by eth_getTransactionByHash.
+type rpcTransaction struct {
+	BlockHash            string            `json:"blockHash"`
+	BlockNumber          hexutil.Uint64    `json:"blockNumber"`
+	From                 string            `json:"from"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerBlobGas     *hexutil.Big      `json:"maxFeePerBlobGas,omitempty"`
+	Hash                 string            `json:"hash"`
+	Input                string            `json:"input"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	To                   *string           `json:"to"`
+	TransactionIndex     hexutil.Uint64    `json:"transactionIndex"`
+	Value                *hexutil.Big      `json:"value"`
+	Type                 hexutil.Uint64    `json:"type"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId,omitempty"`
+	BlobVersionedHashes  []string          `json:"blobVersionedHashes,omitempty"`
+	V                    *hexutil.Big      `json:"v"`
+	R                    *hexutil.Big      `json:"r"`
+	S                    *hexutil.Big      `json:"s"`
+	YParity              *hexutil.Uint64   `json:"yParity,omitempty"`
+}
+
+// rpcPendingTransaction is a transaction not yet in a block, whose block
+// fields are null.
+type rpcPendingTransaction struct {
+	rpcTransaction
+	BlockHash        *string         `json:"blockHash"`
+	BlockNumber      *hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
+}
+
+func newRPCPendingTransaction(tx *pb.Transaction) (rpcPendingTransaction, error) {
+	res, err := newRPCTransaction(tx, "", 0)
+	return rpcPendingTransaction{rpcTransaction: res}, err
+}
+
+// newRPCTransaction converts the transaction, failing with errIncomplete
+// when its signature is not indexed.
+func newRPCTransaction(tx *pb.Transaction, blockHash string, index uint64) (rpcTransaction, error) {
+	if tx.R == "" {
+		return rpcTransaction{}, errIncomplete
+	}
+	res := rpcTransaction{
+		BlockHash:            blockHash,
+		BlockNumber:          hexutil.Uint64(tx.BlockNum),
+		From:                 tx.FromAddr,
+		Gas:                  hexutil.Uint64(tx.Gas),
+		GasPrice:             hexBig(tx.GasPrice),
+		MaxFeePerGas:         hexBig(tx.MaxFeePerGas),
+		MaxPriorityFeePerGas: hexBig(tx.MaxPriorityFeePerGas),
+		MaxFeePerBlobGas:     hexBig(tx.MaxFeePerBlobGas),
+		Hash:                 tx.TxHash,
+		Input:                tx.Data,
+		Nonce:                hexutil.Uint64(tx.Nonce),
+		To:                   optional(tx.ToAddr),
+		TransactionIndex:     hexutil.Uint64(index),
+		Value:                hexBig(tx.Value),
+		Type:                 hexutil.Uint64(tx.Type),
+		ChainID:              hexBig(tx.ChainId),
+		BlobVersionedHashes:  tx.BlobHashes,
+		V:                    hexBig(tx.V),
+		R:                    hexBig(tx.R),
+		S:                    hexBig(tx.S),
+	}
+	// a mined transaction reports the price it paid
+	if tx.Receipt != nil && tx.Receipt.EffectiveGasPrice != "" {
+		res.GasPrice = hexBig(tx.Receipt.EffectiveGasPrice)
+	}
+	if tx.Type != types.LegacyTxType {
+		accessList := make(types.AccessList, len(tx.AccessList))
+		for i, tuple := range tx.AccessList {
+			accessList[i].Address = common.HexToAddress(tuple.Address)
+			accessList[i].StorageKeys = make([]common.Hash, len(tuple.StorageKeys))
+			for j, key := range tuple.StorageKeys {
+				accessList[i].StorageKeys[j] = common.HexToHash(key)
+			}
+		}
+		res.AccessList = &accessList
+		// the v of a typed transaction is its y parity
+		yParity := hexutil.Uint64(res.V.ToInt().Uint64())
+		res.YParity = &yParity
+	}
+	return res, nil
+}
+
+// rpcReceipt is a receipt as returned by eth_getTransactionReceipt.
+type rpcReceipt struct {
+	BlockHash         string         `json:"blockHash"`
+	BlockNumber       hexutil.Uint64 `json:"blockNumber"`
+	ContractAddress   *string        `json:"contractAddress"`
+	CumulativeGasUsed hexutil.Uint64 `json:"cumulativeGasUsed"`
+	EffectiveGasPrice *hexutil.Big   `json:"effectiveGasPrice"`
+	From              string         `json:"from"`
+	GasUsed           hexutil.Uint64 `json:"gasUsed"`
+	Logs              []rpcLog       `json:"logs"`
+	LogsBloom         types.Bloom    `json:"logsBloom"`
+	Status            hexutil.Uint64 `json:"status"`
+	To                *string        `json:"to"`
+	TransactionHash   string         `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64 `json:"transactionIndex"`
+	Type              hexutil.Uint64 `json:"type"`
+}
+
+// newRPCReceipt converts the receipt of the transaction. The logs bloom is
+// not indexed, but built from the logs as the node does.
+func newRPCReceipt(tx *pb.Transaction) rpcReceipt {
+	receipt := tx.Receipt
+	logs := make([]rpcLog, len(receipt.Logs))
+	bloomLogs := make([]*types.Log, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		logs[i] = newRPCLog(log)
+		bloomLogs[i] = &types.Log{Address: common.HexToAddress(log.Address)}
+		for _, topic := range log.Topics {
+			bloomLogs[i].Topics = append(bloomLogs[i].Topics, common.HexToHash(topic))
+		}
+	}
+	return rpcReceipt{
+		BlockHash:         receipt.BlockHash,
+		BlockNumber:       hexutil.Uint64(receipt.BlockNum),
+		ContractAddress:   optional(receipt.ContractAddress),
+		CumulativeGasUsed: hexutil.Uint64(receipt.CumulativeGasUsed),
+		EffectiveGasPrice: hexBig(receipt.EffectiveGasPrice),
+		From:              tx.FromAddr,
+		GasUsed:           hexutil.Uint64(receipt.GasUsed),
+		Logs:              logs,
+		LogsBloom:         types.BytesToBloom(types.LogsBloom(bloomLogs)),
+		Status:            hexutil.Uint64(receipt.Status),
+		To:                optional(tx.ToAddr),
+		TransactionHash:   receipt.TxHash,
+		TransactionIndex:  hexutil.Uint64(receipt.TxIndex),
+		Type:              hexutil.Uint64(receipt.Type),
+	}
+}
+
+type rpcLog struct {
+	Address          string         `json:"address"`
+	Topics           []string       `json:"topics"`
+	Data             string         `json:"data"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionHash  string         `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	BlockHash        string         `json:"blockHash"`
+	LogIndex         hexutil.Uint64 `json:"logIndex"`
+	Removed          bool           `json:"removed"`
+}
+
+func newRPCLog(log *pb.Log) rpcLog {
+	topics := log.Topics
+	if topics == nil {
+		topics = []string{}
+	}
+	return rpcLog{
+		Address:          log.Address,
+		Topics:           topics,
+		Data:             log.Data,
+		BlockNumber:      hexutil.Uint64(log.BlockNum),
+		TransactionHash:  log.TxHash,
+		TransactionIndex: hexutil.Uint64(log.TxIndex),
+		BlockHash:        log.BlockHash,
+		LogIndex:         hexutil.Uint64(log.Index),
+		Removed:          log.Removed,
+	}
+}
+
+// hexBig converts a decimal amount, nil when it is empty.
+func hexBig(amount string) *hexutil.Big {
+	n, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil
+	}
+	return (*hexutil.Big)(n)
+}
+
+// optional returns nil for an empty address or hash, which JSON-RPC reports as
+// null or leaves out.
+func optional(address string) *string {
+	if address == "" {
+		return nil
+	}
+	return &address
+}
@@ -0,0 +1,341 @@
+package router
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gin-gonic/gin"
+	"github.com/holiman/uint256"
+	gogrpc "google.golang.org/grpc"
+
+	"Kumazan/go-ethereum-server/pb"
+	"Kumazan/go-ethereum-server/pkg/grpc"
+	"Kumazan/go-ethereum-server/pkg/model"
+	"Kumazan/go-ethereum-server/pkg/node"
+	"Kumazan/go-ethereum-server/pkg/service"
+)
+
+// fakeService serves the blocks and transactions it holds, as indexed, and
+// the events sent on its feeds.
+type fakeService struct {
+	service.EthereumService
+	blocks map[uint64]*model.Block
+	txs    map[string]*model.Transaction
+
+	blockFeed   event.Feed
+	pendingFeed event.Feed
+}
+
+func (s *fakeService) GetBlock(ctx context.Context, num uint64) (*model.Block, error) {
+	block, ok := s.blocks[num]
+	if !ok {
+		return nil, service.ErrNotFound
+	}
+	return block, nil
+}
+
+func (s *fakeService) GetBlockByHash(ctx context.Context, hash string, orphaned bool) (*model.Block, error) {
+	for _, block := range s.blocks {
+		if block.BlockHash == hash {
+			return block, nil
+		}
+	}
+	return nil, service.ErrNotFound
+}
+
+func (s *fakeService) GetBlockTransactions(ctx context.Context, block *model.Block) ([]*model.Transaction, error) {
+	return block.Transactions, nil
+}
+
+func (s *fakeService) GetTransaction(ctx context.Context, txHash string) (*model.Transaction, error) {
+	tx, ok := s.txs[txHash]
+	if !ok {
+		return nil, service.ErrNotFound
+	}
+	return tx, nil
+}
+
+func (s *fakeService) GetReceipt(ctx context.Context, txHash string) (*model.Receipt, error) {
+	tx, ok := s.txs[txHash]
+	if !ok {
+		return nil, service.ErrNotFound
+	}
+	return tx.Receipt, nil
+}
+
+// index stores the block with its receipts as the indexer would.
+func (s *fakeService) index(t *testing.T, block *types.Block, receipts types.Receipts, signer types.Signer) *model.Block {
+	b, err := model.NewBlock(block, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tx := range b.Transactions {
+		tx.Receipt = model.NewReceipt(receipts[i])
+		b.TxHash = append(b.TxHash, tx.TxHash)
+		s.txs[tx.TxHash] = tx
+	}
+	s.blocks[b.BlockNum] = b
+	return b
+}
+
+// startRouter serves the router in front of an indexer with svc and returns
+// its URL.
+func startRouter(t *testing.T, svc service.EthereumService, upstream *node.Pool) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer(svc)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := gogrpc.Dial(lis.Addr().String(), gogrpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	ec := &grpc.EthereumClient{ClientConn: conn, EthereumServiceClient: pb.NewEthereumServiceClient(conn)}
+
+	gin.SetMode(gin.TestMode)
+	ts := httptest.NewServer(New(ec, upstream).Engine)
+	t.Cleanup(ts.Close)
+	return ts.URL
+}
+
+// listHasher hashes the transactions or receipts of a block in place of the
+// trie of the node, the roots only need to be consistent with the block.
+type listHasher struct {
+	data []byte
+}
+
+func (h *listHasher) Reset() {
+	h.data = h.data[:0]
+}
+
+func (h *listHasher) Update(key, value []byte) error {
+	h.data = append(append(h.data, key...), value...)
+	return nil
+}
+
+func (h *listHasher) Hash() common.Hash {
+	return crypto.Keccak256Hash(h.data)
+}
+
+// testBlock returns a Cancun block holding a transaction of each type, with
+// their receipts.
+func testBlock(t *testing.T, signer types.Signer) (*types.Block, types.Receipts) {
+	key, err := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	if err != nil {
+		t.Fatal(err)
+	}
+	to := common.HexToAddress("0x02")
+	sign := func(signer types.Signer, tx types.TxData) *types.Transaction {
+		signed, err := types.SignNewTx(key, signer, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+	txs := []*types.Transaction{
+		sign(types.HomesteadSigner{}, &types.LegacyTx{Nonce: 0, GasPrice: big.NewInt(3e9), Gas: 21000, To: &to, Value: big.NewInt(1)}),
+		sign(signer, &types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(3e9), Gas: 21000, To: &to, Value: big.NewInt(2)}),
+		sign(signer, &types.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     2,
+			GasTipCap: big.NewInt(1e9),
+			GasFeeCap: big.NewInt(4e9),
+			Gas:       100000,
+			Data:      []byte{0x60, 0x00},
+			AccessList: types.AccessList{{
+				Address:     to,
+				StorageKeys: []common.Hash{common.HexToHash("0x01")},
+			}},
+		}),
+		sign(signer, &types.BlobTx{
+			ChainID:    uint256.NewInt(1),
+			Nonce:      3,
+			GasTipCap:  uint256.NewInt(1e9),
+			GasFeeCap:  uint256.NewInt(4e9),
+			Gas:        21000,
+			To:         to,
+			BlobFeeCap: uint256.NewInt(1),
+			BlobHashes: []common.Hash{common.HexToHash("0x0100000000000000000000000000000000000000000000000000000000000001")},
+		}),
+	}
+
+	header := &types.Header{
+		ParentHash:       common.HexToHash("0x0a"),
+		Coinbase:         common.HexToAddress("0x0c"),
+		Root:             common.HexToHash("0x0b"),
+		Difficulty:       common.Big0,
+		Number:           big.NewInt(7),
+		GasLimit:         30000000,
+		GasUsed:          163000,
+		Time:             1700000000,
+		Extra:            []byte("extra"),
+		MixDigest:        common.HexToHash("0x0d"),
+		BaseFee:          big.NewInt(2e9),
+		BlobGasUsed:      new(uint64),
+		ExcessBlobGas:    new(uint64),
+		ParentBeaconRoot: &common.Hash{0x0e},
+	}
+	*header.BlobGasUsed = 131072
+	receipts := make(types.Receipts, len(txs))
+	for i, tx := range txs {
+		receipts[i] = &types.Receipt{
+			Type:              tx.Type(),
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(i+1) * 21000,
+			TxHash:            tx.Hash(),
+			GasUsed:           21000,
+			EffectiveGasPrice: big.NewInt(3e9),
+			BlockNumber:       header.Number,
+			TransactionIndex:  uint(i),
+		}
+	}
+	receipts[2].Logs = []*types.Log{{
+		Address: to,
+		Topics:  []common.Hash{common.HexToHash(model.TransferTopic), common.HexToHash("0x01")},
+		Data:    common.HexToHash("0x05").Bytes(),
+		TxHash:  txs[2].Hash(),
+		TxIndex: 2,
+	}}
+	for _, receipt := range receipts {
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+	}
+	withdrawals := []*types.Withdrawal{{Index: 1, Validator: 2, Address: to, Amount: 3}}
+	block := types.NewBlockWithWithdrawals(header, txs, nil, receipts, withdrawals, new(listHasher))
+	for _, receipt := range receipts {
+		receipt.BlockHash = block.Hash()
+		for _, log := range receipt.Logs {
+			log.BlockHash, log.BlockNumber = block.Hash(), block.NumberU64()
+		}
+	}
+	return block, receipts
+}
+
+func TestRPCRoundTrip(t *testing.T) {
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	block, receipts := testBlock(t, signer)
+	svc := &fakeService{blocks: map[uint64]*model.Block{}, txs: map[string]*model.Transaction{}}
+	svc.index(t, block, receipts, signer)
+
+	client, err := ethclient.Dial(startRouter(t, svc, nil) + "/rpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	got, err := client.BlockByNumber(ctx, block.Number())
+	if err != nil {
+		t.Fatalf("BlockByNumber failed: %v", err)
+	}
+	if got.Hash() != block.Hash() {
+		t.Errorf("block hash = %s, want %s", got.Hash(), block.Hash())
+	}
+	if root := types.DeriveSha(got.Transactions(), new(listHasher)); root != block.TxHash() {
+		t.Errorf("transactions root = %s, want %s", root, block.TxHash())
+	}
+	if len(got.Withdrawals()) != 1 || *got.Withdrawals()[0] != *block.Withdrawals()[0] {
+		t.Errorf("withdrawals = %v, want %v", got.Withdrawals(), block.Withdrawals())
+	}
+
+	gotReceipts := make(types.Receipts, len(receipts))
+	for i, tx := range block.Transactions() {
+		gotTx, _, err := client.TransactionByHash(ctx, tx.Hash())
+		if err != nil {
+			t.Fatalf("TransactionByHash %s failed: %v", tx.Hash(), err)
+		}
+		if gotTx.Hash() != tx.Hash() {
+			t.Errorf("transaction hash = %s, want %s", gotTx.Hash(), tx.Hash())
+		}
+		if gotReceipts[i], err = client.TransactionReceipt(ctx, tx.Hash()); err != nil {
+			t.Fatalf("TransactionReceipt %s failed: %v", tx.Hash(), err)
+		}
+		if gotReceipts[i].Bloom != receipts[i].Bloom {
+			t.Errorf("receipt %d bloom differs", i)
+		}
+	}
+	if root := types.DeriveSha(gotReceipts, new(listHasher)); root != block.ReceiptHash() {
+		t.Errorf("receipts root = %s, want %s", root, block.ReceiptHash())
+	}
+}
+
+// upstreamNode answers the blocks the index does not hold fully.
+type upstreamNode struct{}
+
+func (upstreamNode) BlockNumber() (string, error) {
+	return "0x7", nil
+}
+
+func (upstreamNode) GetBlockByNumber(tag string, full bool) (map[string]string, error) {
+	return map[string]string{"hash": "0xupstream"}, nil
+}
+
+func (upstreamNode) GetTransactionByHash(hash string) (map[string]string, error) {
+	return map[string]string{"hash": "0xupstream"}, nil
+}
+
+func TestRPCIncomplete(t *testing.T) {
+	// stored before the header and signature fields were indexed
+	tx := &model.Transaction{TxHash: common.HexToHash("0x01").String(), Gas: 21000, Data: "0x",
+		Receipt: &model.Receipt{}}
+	svc := &fakeService{
+		blocks: map[uint64]*model.Block{7: {BlockNum: 7, BlockHash: common.HexToHash("0x07").String()}},
+		txs:    map[string]*model.Transaction{tx.TxHash: tx},
+	}
+	calls := []struct {
+		method string
+		args   []interface{}
+	}{
+		{"eth_getBlockByNumber", []interface{}{"0x7", false}},
+		{"eth_getTransactionByHash", []interface{}{tx.TxHash}},
+	}
+
+	client, err := rpc.Dial(startRouter(t, svc, nil) + "/rpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	for _, call := range calls {
+		var result map[string]interface{}
+		if err := client.Call(&result, call.method, call.args...); err == nil {
+			t.Errorf("%s without upstream = %v, want an error", call.method, result)
+		}
+	}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", upstreamNode{}); err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	upstream, err := node.Dial([]string{ts.URL}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err = rpc.Dial(startRouter(t, svc, upstream) + "/rpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	for _, call := range calls {
+		var result map[string]interface{}
+		if err := client.Call(&result, call.method, call.args...); err != nil {
+			t.Fatalf("%s failed: %v", call.method, err)
+		}
+		if result["hash"] != "0xupstream" {
+			t.Errorf("%s = %v, want the upstream answer", call.method, result)
+		}
+	}
+}
@@ -0,0 +1,507 @@
+package router
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"Kumazan/go-ethereum-server/pb"
+	"Kumazan/go-ethereum-server/pkg/model"
+)
+
+const (
+	wsWriteTimeout   = time.Second * 10
+	wsPingInterval   = time.Second * 30
+	wsPongWait       = time.Second * 60
+	wsMaxMessageSize = 1 << 16
+
+	// limits of a single connection
+	wsQueueSize        = 256
+	maxWSSubscriptions = 16
+	maxFilterAddresses = 100
+	maxFilterTopics    = 100
+)
+
+// the block feed is public, so pages of any origin may open it
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// blockEvent is a block added to the chain, or removed from it by a reorg.
+type blockEvent struct {
+	Block   *pb.Block `json:"block"`
+	Removed bool      `json:"removed"`
+}
+
+// streamBlocks relays the block feed as server-sent events.
+func (h *Handler) streamBlocks(c *gin.Context) {
+	stream, err := h.ec.SubscribeBlocks(c.Request.Context(), &pb.SubscribeBlocksRequest{})
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	c.Stream(func(w io.Writer) bool {
+		ev, err := stream.Recv()
+		if err != nil {
+			return false
+		}
+		c.SSEvent("block", blockEvent{Block: ev.Block, Removed: ev.Removed})
+		return true
+	})
+}
+
+// wsBlocks relays the block feed over a WebSocket, one JSON message per event.
+func (h *Handler) wsBlocks(c *gin.Context) {
+	ws, err := h.upgrade(c)
+	if err != nil {
+		return
+	}
+	defer ws.close(websocket.CloseNormalClosure, "")
+
+	stream, err := h.ec.SubscribeBlocks(ws.ctx, &pb.SubscribeBlocksRequest{})
+	if err != nil {
+		ws.close(closeReason(err))
+		return
+	}
+	go ws.relay(ws.ctx, func() (interface{}, error) {
+		ev, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return blockEvent{Block: ev.Block, Removed: ev.Removed}, nil
+	})
+
+	// the client sends nothing, reading notices when it goes away
+	ws.readLoop(func([]byte) {})
+}
+
+// wsSubscribe serves eth_subscribe and eth_unsubscribe over a WebSocket. The
+// subscriptions are newHeads and logs with an address and topics filter, sent
+// as a node sends them, and newPendingTransactions and minedTransactions,
+// which may be filtered by the addresses sending or receiving them.
+func (h *Handler) wsSubscribe(c *gin.Context) {
+	ws, err := h.upgrade(c)
+	if err != nil {
+		return
+	}
+	defer ws.close(websocket.CloseNormalClosure, "")
+
+	ws.readLoop(func(msg []byte) {
+		var req rpcRequest
+		if err := json.Unmarshal(msg, &req); err != nil {
+			ws.send(rpcErrorResponse(nil, errCodeParse, "parse error"))
+			return
+		}
+		h.handleWS(ws, &req)
+	})
+}
+
+func wsNotification(subID string, result interface{}) gin.H {
+	return gin.H{
+		"jsonrpc": "2.0",
+		"method":  "eth_subscription",
+		"params":  gin.H{"subscription": subID, "result": result},
+	}
+}
+
+func (h *Handler) handleWS(ws *wsConn, req *rpcRequest) {
+	switch req.Method {
+	case "eth_subscribe":
+		id, relay, err := h.subscribe(ws, req.Params)
+		if err != nil {
+			ws.send(rpcErrorResponse(req.ID, err.Code, err.Message))
+			return
+		}
+		// the id goes out before the first notification
+		ws.send(rpcResponse(req.ID, id))
+		go relay()
+	case "eth_unsubscribe":
+		var id string
+		if len(req.Params) != 1 || json.Unmarshal(req.Params[0], &id) != nil {
+			ws.send(rpcErrorResponse(req.ID, errCodeInvalidParams, "subscription id is invalid"))
+			return
+		}
+		ws.send(rpcResponse(req.ID, ws.unsubscribe(id)))
+	default:
+		ws.send(rpcErrorResponse(req.ID, errCodeMethodNotFound, "method not found"))
+	}
+}
+
+// subscribe opens the feed of a subscription and returns its id, and relay to
+// start sending its notifications.
+func (h *Handler) subscribe(ws *wsConn, params []json.RawMessage) (string, func(), *rpcError) {
+	var kind string
+	if len(params) == 0 || json.Unmarshal(params[0], &kind) != nil {
+		return "", nil, &rpcError{Code: errCodeInvalidParams, Message: "subscription kind is invalid"}
+	}
+	var rawFilter json.RawMessage
+	if len(params) > 1 {
+		rawFilter = params[1]
+	}
+
+	ctx, cancel := context.WithCancel(ws.ctx)
+	id, ok := ws.addSubscription(cancel)
+	if !ok {
+		cancel()
+		return "", nil, &rpcError{Code: errCodeLimitExceeded, Message: "too many subscriptions"}
+	}
+
+	var recv func() (interface{}, error)
+	var err error
+	switch kind {
+	case "newHeads":
+		var stream pb.EthereumService_SubscribeBlocksClient
+		stream, err = h.ec.SubscribeBlocks(ctx, &pb.SubscribeBlocksRequest{})
+		recv = func() (interface{}, error) {
+			for {
+				ev, err := stream.Recv()
+				if err != nil {
+					return nil, err
+				}
+				// a node only sends the heads of the new branch on a reorg
+				if ev.Removed {
+					continue
+				}
+				return newRPCHeader(ev.Block)
+			}
+		}
+	case "logs":
+		req, rpcErr := parseLogsFilter(rawFilter)
+		if rpcErr != nil {
+			ws.unsubscribe(id)
+			return "", nil, rpcErr
+		}
+		var stream pb.EthereumService_SubscribeLogsClient
+		stream, err = h.ec.SubscribeLogs(ctx, req)
+		recv = func() (interface{}, error) {
+			log, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return newRPCLog(log), nil
+		}
+	case "newPendingTransactions", "minedTransactions":
+		req, full, rpcErr := parseTxFilter(rawFilter)
+		if rpcErr != nil {
+			ws.unsubscribe(id)
+			return "", nil, rpcErr
+		}
+		req.Pending = kind == "newPendingTransactions"
+		var stream pb.EthereumService_SubscribeTransactionsClient
+		stream, err = h.ec.SubscribeTransactions(ctx, req)
+		recv = func() (interface{}, error) {
+			ev, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			if req.Pending {
+				if !full {
+					return ev.Tx.TxHash, nil
+				}
+				return newRPCPendingTransaction(ev.Tx)
+			}
+			return newMinedTxEvent(ev)
+		}
+	default:
+		ws.unsubscribe(id)
+		return "", nil, &rpcError{Code: errCodeInvalidParams, Message: "subscription kind is invalid"}
+	}
+	if err != nil {
+		ws.unsubscribe(id)
+		return "", nil, &rpcError{Code: errCodeInternal, Message: "subscription failed"}
+	}
+
+	return id, func() {
+		ws.relay(ctx, func() (interface{}, error) {
+			result, err := recv()
+			if err != nil {
+				return nil, err
+			}
+			return wsNotification(id, result), nil
+		})
+	}, nil
+}
+
+// parseLogsFilter parses an eth_subscribe logs filter, whose address is an
+// address or a list of them and whose topics hold, at each position, null, a
+// topic or a list of topics.
+func parseLogsFilter(raw json.RawMessage) (*pb.SubscribeLogsRequest, *rpcError) {
+	var filter struct {
+		Address json.RawMessage   `json:"address"`
+		Topics  []json.RawMessage `json:"topics"`
+	}
+	if len(raw) > 0 && json.Unmarshal(raw, &filter) != nil {
+		return nil, &rpcError{Code: errCodeInvalidParams, Message: "filter is invalid"}
+	}
+	req := &pb.SubscribeLogsRequest{}
+
+	addresses, err := stringOrList(filter.Address)
+	if err != nil || len(addresses) > maxFilterAddresses {
+		return nil, &rpcError{Code: errCodeInvalidParams, Message: "address is invalid"}
+	}
+	for _, address := range addresses {
+		if !addressValidator.MatchString(address) {
+			return nil, &rpcError{Code: errCodeInvalidParams, Message: "address is invalid"}
+		}
+		req.Addresses = append(req.Addresses, common.HexToAddress(address).String())
+	}
+
+	if len(filter.Topics) > 4 {
+		return nil, &rpcError{Code: errCodeInvalidParams, Message: "topics is invalid"}
+	}
+	req.Topics = make([]*pb.TopicFilter, len(filter.Topics))
+	for i, position := range filter.Topics {
+		topics, err := stringOrList(position)
+		if err != nil || len(topics) > maxFilterTopics {
+			return nil, &rpcError{Code: errCodeInvalidParams, Message: "topics is invalid"}
+		}
+		req.Topics[i] = &pb.TopicFilter{}
+		for _, topic := range topics {
+			if !hashValidator.MatchString(topic) {
+				return nil, &rpcError{Code: errCodeInvalidParams, Message: "topics is invalid"}
+			}
+			req.Topics[i].Topics = append(req.Topics[i].Topics, strings.ToLower(topic))
+		}
+	}
+	return req, nil
+}
+
+// parseTxFilter parses the parameter of a transactions subscription. It is
+// the flag for full transactions that go-ethereum takes, or a filter of the
+// addresses, one or a list, the transactions are sent or received by, with
+// their direction and the flag.
+func parseTxFilter(raw json.RawMessage) (*pb.SubscribeTransactionsRequest, bool, *rpcError) {
+	var full bool
+	if len(raw) == 0 || json.Unmarshal(raw, &full) == nil {
+		return &pb.SubscribeTransactionsRequest{}, full, nil
+	}
+	var filter struct {
+		Address   json.RawMessage `json:"address"`
+		Direction string          `json:"direction"`
+		Full      bool            `json:"full"`
+	}
+	if json.Unmarshal(raw, &filter) != nil {
+		return nil, false, &rpcError{Code: errCodeInvalidParams, Message: "filter is invalid"}
+	}
+	req := &pb.SubscribeTransactionsRequest{Direction: filter.Direction}
+
+	addresses, err := stringOrList(filter.Address)
+	if err != nil || len(addresses) > maxFilterAddresses {
+		return nil, false, &rpcError{Code: errCodeInvalidParams, Message: "address is invalid"}
+	}
+	for _, address := range addresses {
+		if !addressValidator.MatchString(address) {
+			return nil, false, &rpcError{Code: errCodeInvalidParams, Message: "address is invalid"}
+		}
+		req.Addresses = append(req.Addresses, common.HexToAddress(address).String())
+	}
+
+	switch filter.Direction {
+	case "", model.DirectionIn, model.DirectionOut, model.DirectionBoth:
+	default:
+		return nil, false, &rpcError{Code: errCodeInvalidParams, Message: "direction is invalid"}
+	}
+	return req, filter.Full, nil
+}
+
+// minedTxEvent is a transaction included in a block, or whose block was
+// removed by a reorg.
+type minedTxEvent struct {
+	Transaction rpcTransaction `json:"transaction"`
+	Removed     bool           `json:"removed"`
+}
+
+func newMinedTxEvent(ev *pb.TransactionEvent) (minedTxEvent, error) {
+	var blockHash string
+	var index uint64
+	if receipt := ev.Tx.Receipt; receipt != nil {
+		blockHash, index = receipt.BlockHash, uint64(receipt.TxIndex)
+	}
+	tx, err := newRPCTransaction(ev.Tx, blockHash, index)
+	return minedTxEvent{Transaction: tx, Removed: ev.Removed}, err
+}
+
+// stringOrList parses null, a string or a list of strings.
+func stringOrList(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return []string{value}, nil
+	}
+	var values []string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// wsConn is a WebSocket connection with its subscriptions. A single goroutine
+// writes the queued messages; a client that reads too slowly to keep the queue
+// from filling up is disconnected.
+type wsConn struct {
+	*websocket.Conn
+	ctx       context.Context
+	cancel    context.CancelFunc
+	queue     chan interface{}
+	closeOnce sync.Once
+
+	mu   sync.Mutex
+	subs map[string]context.CancelFunc
+}
+
+// upgrade upgrades the request to a WebSocket and starts writing to it. When
+// it fails, the upgrader has replied with the error.
+func (h *Handler) upgrade(c *gin.Context) (*wsConn, error) {
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(h.ctx)
+	ws := &wsConn{
+		Conn:   conn,
+		ctx:    ctx,
+		cancel: cancel,
+		queue:  make(chan interface{}, wsQueueSize),
+		subs:   make(map[string]context.CancelFunc),
+	}
+	go ws.writeLoop()
+	return ws, nil
+}
+
+// readLoop passes the messages of the client to handle until the connection
+// fails or closes. A client that does not answer pings is disconnected.
+func (ws *wsConn) readLoop(handle func([]byte)) {
+	ws.SetReadLimit(wsMaxMessageSize)
+	ws.SetReadDeadline(time.Now().Add(wsPongWait))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+	for {
+		_, msg, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		handle(msg)
+	}
+}
+
+// writeLoop writes the queued messages and pings the client until the
+// connection closes.
+func (ws *wsConn) writeLoop() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case msg := <-ws.queue:
+			ws.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := ws.WriteJSON(msg); err != nil {
+				ws.close(websocket.CloseInternalServerErr, "write failed")
+				return
+			}
+		case <-ticker.C:
+			deadline := time.Now().Add(wsWriteTimeout)
+			if err := ws.WriteControl(websocket.PingMessage, nil, deadline); err != nil {
+				ws.close(websocket.CloseInternalServerErr, "ping failed")
+				return
+			}
+		case <-ws.ctx.Done():
+			return
+		}
+	}
+}
+
+// send queues a message for the client.
+func (ws *wsConn) send(msg interface{}) {
+	select {
+	case ws.queue <- msg:
+	case <-ws.ctx.Done():
+	default:
+		ws.close(websocket.CloseTryAgainLater, "client fell behind")
+	}
+}
+
+// relay sends the messages returned by recv until ctx is done. A feed that
+// ends for another reason, such as falling behind, closes the connection.
+func (ws *wsConn) relay(ctx context.Context, recv func() (interface{}, error)) {
+	for {
+		msg, err := recv()
+		if err != nil {
+			if ctx.Err() == nil {
+				ws.close(closeReason(err))
+			}
+			return
+		}
+		ws.send(msg)
+	}
+}
+
+// close tells the client why the connection closes, and stops every
+// subscription.
+func (ws *wsConn) close(code int, text string) {
+	ws.closeOnce.Do(func() {
+		ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text),
+			time.Now().Add(wsWriteTimeout))
+		ws.cancel()
+		ws.Conn.Close()
+	})
+}
+
+func (ws *wsConn) addSubscription(cancel context.CancelFunc) (string, bool) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if len(ws.subs) >= maxWSSubscriptions {
+		return "", false
+	}
+	id := newSubscriptionID()
+	ws.subs[id] = cancel
+	return id, true
+}
+
+func (ws *wsConn) unsubscribe(id string) bool {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	cancel, ok := ws.subs[id]
+	if ok {
+		cancel()
+		delete(ws.subs, id)
+	}
+	return ok
+}
+
+func newSubscriptionID() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return hexutil.Encode(id)
+}
+
+// closeReason tells why a feed ended. A subscriber dropped for falling behind
+// may try again later, one of a feed the upstream endpoints cannot serve may
+// not.
+func closeReason(err error) (int, string) {
+	if status, ok := status.FromError(err); ok {
+		switch status.Code() {
+		case codes.ResourceExhausted:
+			return websocket.CloseTryAgainLater, status.Message()
+		case codes.FailedPrecondition:
+			return websocket.CloseInternalServerErr, status.Message()
+		case codes.Canceled:
+			return websocket.CloseGoingAway, "feed ended"
+		}
+	}
+	return websocket.CloseInternalServerErr, "feed ended"
+}
@@ -0,0 +1,352 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/gorilla/websocket"
+
+	"Kumazan/go-ethereum-server/pb"
+	"Kumazan/go-ethereum-server/pkg/model"
+)
+
+func (s *fakeService) SubscribeBlocks(ch chan<- *model.BlockEvent) event.Subscription {
+	return s.blockFeed.Subscribe(ch)
+}
+
+func (s *fakeService) SubscribePendingTransactions(ctx context.Context, ch chan<- *model.Transaction) (event.Subscription, error) {
+	return s.pendingFeed.Subscribe(ch), nil
+}
+
+// publish sends the value on the feed once the subscription of the server is
+// in place, the router subscribes on its own time.
+func publish(t *testing.T, feed *event.Feed, value interface{}) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second * 5); feed.Send(value) == 0; {
+		if time.Now().After(deadline) {
+			t.Fatal("nothing subscribed to the feed")
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+// wsMessage is a JSON-RPC response or a subscription notification.
+type wsMessage struct {
+	ID     int
+	Result json.RawMessage
+	Error  *rpcError
+	Params struct {
+		Subscription string
+		Result       json.RawMessage
+	}
+}
+
+func dialWS(t *testing.T, url string) *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(url, "http")+"/ws", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// callWS sends a request and returns its response.
+func callWS(t *testing.T, conn *websocket.Conn, id int, method string, params ...interface{}) *wsMessage {
+	t.Helper()
+	if err := conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": params}); err != nil {
+		t.Fatal(err)
+	}
+	msg := readWS(t, conn)
+	if msg.ID != id {
+		t.Fatalf("response id = %d, want %d", msg.ID, id)
+	}
+	return msg
+}
+
+// subscribeWS subscribes and returns the subscription id.
+func subscribeWS(t *testing.T, conn *websocket.Conn, params ...interface{}) string {
+	t.Helper()
+	msg := callWS(t, conn, 1, "eth_subscribe", params...)
+	var id string
+	if msg.Error != nil || json.Unmarshal(msg.Result, &id) != nil {
+		t.Fatalf("eth_subscribe %v = %s, %v", params, msg.Result, msg.Error)
+	}
+	return id
+}
+
+// notification reads the next notification of the subscription into result.
+func notification(t *testing.T, conn *websocket.Conn, id string, result interface{}) {
+	t.Helper()
+	msg := readWS(t, conn)
+	if msg.Params.Subscription != id {
+		t.Fatalf("notification of %q, want %q", msg.Params.Subscription, id)
+	}
+	if err := json.Unmarshal(msg.Params.Result, result); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readWS(t *testing.T, conn *websocket.Conn) *wsMessage {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	var msg wsMessage
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatal(err)
+	}
+	return &msg
+}
+
+// testEvent returns the indexed test block as the indexer sends it.
+func testEvent(t *testing.T, svc *fakeService) (*types.Block, *model.Block) {
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	block, receipts := testBlock(t, signer)
+	b := svc.index(t, block, receipts, signer)
+	for _, tx := range b.Transactions {
+		tx.Logs = tx.Receipt.Logs
+	}
+	return block, b
+}
+
+func TestWSNewHeads(t *testing.T) {
+	svc := &fakeService{blocks: map[uint64]*model.Block{}, txs: map[string]*model.Transaction{}}
+	block, b := testEvent(t, svc)
+	conn := dialWS(t, startRouter(t, svc, nil))
+
+	id := subscribeWS(t, conn, "newHeads")
+	publish(t, &svc.blockFeed, &model.BlockEvent{Block: b, Removed: true})
+	publish(t, &svc.blockFeed, &model.BlockEvent{Block: b})
+
+	// the removed block is not sent, the header of the added one is
+	var head map[string]interface{}
+	notification(t, conn, id, &head)
+	if head["hash"] != block.Hash().String() || head["number"] != "0x7" || head["parentHash"] != block.ParentHash().String() {
+		t.Errorf("head = %v, want the header of block %s", head, block.Hash())
+	}
+	for _, field := range []string{"transactions", "removed", "block"} {
+		if _, ok := head[field]; ok {
+			t.Errorf("head has %q, want a header", field)
+		}
+	}
+}
+
+func TestWSLogs(t *testing.T) {
+	svc := &fakeService{blocks: map[uint64]*model.Block{}, txs: map[string]*model.Transaction{}}
+	block, b := testEvent(t, svc)
+	conn := dialWS(t, startRouter(t, svc, nil))
+
+	id := subscribeWS(t, conn, "logs", map[string]interface{}{
+		"address": "0x0000000000000000000000000000000000000002",
+		"topics":  []interface{}{model.TransferTopic},
+	})
+	publish(t, &svc.blockFeed, &model.BlockEvent{Block: b})
+	publish(t, &svc.blockFeed, &model.BlockEvent{Block: b, Removed: true})
+
+	tx := block.Transactions()[2]
+	for _, removed := range []bool{false, true} {
+		var log struct {
+			Address         common.Address
+			TransactionHash common.Hash
+			BlockHash       common.Hash
+			Removed         bool
+		}
+		notification(t, conn, id, &log)
+		if log.Address != common.HexToAddress("0x02") || log.TransactionHash != tx.Hash() ||
+			log.BlockHash != block.Hash() || log.Removed != removed {
+			t.Errorf("log = %+v, want the log of %s, removed %v", log, tx.Hash(), removed)
+		}
+	}
+}
+
+func TestWSTransactions(t *testing.T) {
+	svc := &fakeService{blocks: map[uint64]*model.Block{}, txs: map[string]*model.Transaction{}}
+	block, b := testEvent(t, svc)
+	conn := dialWS(t, startRouter(t, svc, nil))
+	// the third transaction creates a contract, the others are sent to 0x02
+	filter := map[string]interface{}{"address": []string{"0x0000000000000000000000000000000000000002"}, "direction": "in"}
+
+	mined := subscribeWS(t, conn, "minedTransactions", filter)
+	publish(t, &svc.blockFeed, &model.BlockEvent{Block: b})
+	publish(t, &svc.blockFeed, &model.BlockEvent{Block: b, Removed: true})
+	for _, removed := range []bool{false, true} {
+		for _, i := range []int{0, 1, 3} {
+			var ev struct {
+				Transaction struct {
+					Hash             common.Hash
+					BlockHash        common.Hash
+					TransactionIndex hexutil.Uint64
+				}
+				Removed bool
+			}
+			notification(t, conn, mined, &ev)
+			if ev.Transaction.Hash != block.Transactions()[i].Hash() || ev.Transaction.BlockHash != block.Hash() ||
+				ev.Transaction.TransactionIndex != hexutil.Uint64(i) || ev.Removed != removed {
+				t.Errorf("mined transaction = %+v, want transaction %d, removed %v", ev, i, removed)
+			}
+		}
+	}
+	if got := callWS(t, conn, 2, "eth_unsubscribe", mined).Result; string(got) != "true" {
+		t.Fatalf("eth_unsubscribe = %s, want true", got)
+	}
+
+	// the pending transactions are not indexed, they have no receipts
+	pending := func(i int) *model.Transaction {
+		tx := *b.Transactions[i]
+		tx.Receipt, tx.Logs = nil, nil
+		return &tx
+	}
+	hashes := subscribeWS(t, conn, "newPendingTransactions", filter)
+	publish(t, &svc.pendingFeed, pending(2))
+	publish(t, &svc.pendingFeed, pending(1))
+	var hash common.Hash
+	notification(t, conn, hashes, &hash)
+	if hash != block.Transactions()[1].Hash() {
+		t.Errorf("pending transaction hash = %s, want %s", hash, block.Transactions()[1].Hash())
+	}
+
+	// every transaction is sent by the same key, the full transactions are
+	// those sent out by it
+	svc = &fakeService{blocks: map[uint64]*model.Block{}, txs: map[string]*model.Transaction{}}
+	conn = dialWS(t, startRouter(t, svc, nil))
+	full := subscribeWS(t, conn, "newPendingTransactions", map[string]interface{}{
+		"address": b.Transactions[0].FromAddr, "direction": "out", "full": true,
+	})
+	publish(t, &svc.pendingFeed, pending(0))
+	var tx map[string]interface{}
+	notification(t, conn, full, &tx)
+	if tx["hash"] != block.Transactions()[0].Hash().String() || tx["blockHash"] != nil {
+		t.Errorf("pending transaction = %v, want %s without a block", tx, block.Transactions()[0].Hash())
+	}
+}
+
+func TestWSSubscriptions(t *testing.T) {
+	svc := &fakeService{blocks: map[uint64]*model.Block{}, txs: map[string]*model.Transaction{}}
+	conn := dialWS(t, startRouter(t, svc, nil))
+
+	for _, params := range [][]interface{}{
+		{},
+		{"syncing"},
+		{"logs", map[string]interface{}{"address": "0x02"}},
+		{"minedTransactions", map[string]interface{}{"direction": "sideways"}},
+	} {
+		if msg := callWS(t, conn, 1, "eth_subscribe", params...); msg.Error == nil || msg.Error.Code != errCodeInvalidParams {
+			t.Errorf("eth_subscribe %v error = %v, want invalid params", params, msg.Error)
+		}
+	}
+
+	ids := make([]string, maxWSSubscriptions)
+	for i := range ids {
+		ids[i] = subscribeWS(t, conn, "newHeads")
+	}
+	msg := callWS(t, conn, 2, "eth_subscribe", "newHeads")
+	if msg.Error == nil || msg.Error.Code != errCodeLimitExceeded {
+		t.Fatalf("subscription %d error = %v, want too many subscriptions", maxWSSubscriptions+1, msg.Error)
+	}
+
+	for _, want := range []string{"true", "false"} {
+		if got := callWS(t, conn, 3, "eth_unsubscribe", ids[0]).Result; string(got) != want {
+			t.Errorf("eth_unsubscribe = %s, want %s", got, want)
+		}
+	}
+	// the subscription that ended makes room for another
+	subscribeWS(t, conn, "newHeads")
+}
+
+func TestParseLogsFilter(t *testing.T) {
+	address := "0x00000000000000000000000000000000000000aA"
+	topic := "0x" + strings.Repeat("Ab", 32)
+	lower := strings.ToLower(topic)
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    *pb.SubscribeLogsRequest
+		wantErr bool
+	}{
+		{"no filter", ``, &pb.SubscribeLogsRequest{Topics: []*pb.TopicFilter{}}, false},
+		{"empty filter", `{}`, &pb.SubscribeLogsRequest{Topics: []*pb.TopicFilter{}}, false},
+		{"address", `{"address": "` + address + `"}`,
+			&pb.SubscribeLogsRequest{Addresses: []string{common.HexToAddress(address).String()}, Topics: []*pb.TopicFilter{}}, false},
+		{"addresses", `{"address": ["` + address + `", "0x0000000000000000000000000000000000000002"]}`,
+			&pb.SubscribeLogsRequest{
+				Addresses: []string{common.HexToAddress(address).String(), common.HexToAddress("0x02").String()},
+				Topics:    []*pb.TopicFilter{},
+			}, false},
+		{"topics", `{"topics": [null, "` + topic + `", ["` + topic + `", "` + lower + `"]]}`,
+			&pb.SubscribeLogsRequest{Topics: []*pb.TopicFilter{{}, {Topics: []string{lower}}, {Topics: []string{lower, lower}}}}, false},
+		{"not an object", `[]`, nil, true},
+		{"short address", `{"address": "0x02"}`, nil, true},
+		{"address of another type", `{"address": 2}`, nil, true},
+		{"too many addresses", `{"address": [` + strings.Repeat(`"`+address+`", `, maxFilterAddresses) + `"` + address + `"]}`, nil, true},
+		{"short topic", `{"topics": ["0x01"]}`, nil, true},
+		{"too many positions", `{"topics": [null, null, null, null, null]}`, nil, true},
+		{"too many topics", `{"topics": [[` + strings.Repeat(`"`+topic+`", `, maxFilterTopics) + `"` + topic + `"]]}`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLogsFilter(json.RawMessage(tt.raw))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got.Addresses, tt.want.Addresses) {
+				t.Errorf("addresses = %v, want %v", got.Addresses, tt.want.Addresses)
+			}
+			if len(got.Topics) != len(tt.want.Topics) {
+				t.Fatalf("topics = %v, want %v", got.Topics, tt.want.Topics)
+			}
+			for i := range tt.want.Topics {
+				if !reflect.DeepEqual(got.Topics[i].Topics, tt.want.Topics[i].Topics) {
+					t.Errorf("topics %d = %v, want %v", i, got.Topics[i].Topics, tt.want.Topics[i].Topics)
+				}
+			}
+		})
+	}
+}
+
+func TestParseTxFilter(t *testing.T) {
+	address := "0x00000000000000000000000000000000000000aA"
+
+	tests := []struct {
+		name      string
+		raw       string
+		addresses []string
+		direction string
+		full      bool
+		wantErr   bool
+	}{
+		{"no filter", ``, nil, "", false, false},
+		{"hashes", `false`, nil, "", false, false},
+		{"full transactions", `true`, nil, "", true, false},
+		{"address", `{"address": "` + address + `", "full": true}`, []string{common.HexToAddress(address).String()}, "", true, false},
+		{"direction", `{"address": ["` + address + `"], "direction": "out"}`, []string{common.HexToAddress(address).String()}, "out", false, false},
+		{"not an object", `"yes"`, nil, "", false, true},
+		{"short address", `{"address": "0x02"}`, nil, "", false, true},
+		{"too many addresses", `{"address": [` + strings.Repeat(`"`+address+`", `, maxFilterAddresses) + `"` + address + `"]}`, nil, "", false, true},
+		{"unknown direction", `{"direction": "sideways"}`, nil, "", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, full, err := parseTxFilter(json.RawMessage(tt.raw))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got.Addresses, tt.addresses) || got.Direction != tt.direction || full != tt.full {
+				t.Errorf("filter = %v %q, full %v, want %v %q, full %v", got.Addresses, got.Direction, full, tt.addresses, tt.direction, tt.full)
+			}
+		})
+	}
+}
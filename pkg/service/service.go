@@ -1,93 +1,698 @@
 package service
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"log"
 	"math/big"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"Kumazan/go-ethereum-server/pkg/model"
+	"Kumazan/go-ethereum-server/pkg/node"
 	"Kumazan/go-ethereum-server/pkg/repo"
 )
 
 type EthereumService interface {
-	ListLastestBlocks(ctx context.Context, limit int) ([]*model.Block, error)
+	ListLastestBlocks(ctx context.Context, limit int, finality string, cursor *model.Cursor) ([]*model.Block, error)
+	ListBlocks(ctx context.Context, fromNum uint64, toNum *uint64, finality string) ([]*model.Block, error)
+	GetBlockByHash(ctx context.Context, hash string, orphaned bool) (*model.Block, error)
+	GetBlockByTime(ctx context.Context, timestamp uint64, before bool) (*model.Block, error)
+	ListBlocksByNumber(ctx context.Context, nums []uint64) ([]*model.Block, error)
+	GetBlockTransactions(ctx context.Context, block *model.Block) ([]*model.Transaction, error)
 	GetBlock(ctx context.Context, num uint64) (*model.Block, error)
 	GetTransaction(ctx context.Context, txHash string) (*model.Transaction, error)
+	ListTransactions(ctx context.Context, txHashes []string) ([]*model.Transaction, error)
+	GetReceipt(ctx context.Context, txHash string) (*model.Receipt, error)
+	GetLogs(ctx context.Context, filter *model.LogFilter) ([]*model.Log, error)
+	GetAddressTransactions(ctx context.Context, filter *model.AddressTxFilter) ([]*model.Transaction, error)
+	GetInternalTransactions(ctx context.Context, txHash string) ([]*model.InternalTransaction, error)
+	GetAddressInternalTransactions(ctx context.Context, filter *model.InternalTxFilter) ([]*model.InternalTransaction, error)
+	SetContractABI(ctx context.Context, address, abiJSON string) error
+	ImportSignatures(ctx context.Context, r io.Reader) (int, error)
+	GetTokenTransfers(ctx context.Context, filter *model.TokenTransferFilter) ([]*model.TokenTransfer, error)
+	GetNFTOwners(ctx context.Context, token, tokenID string) ([]*model.NFTHolding, error)
+	GetNFTInventory(ctx context.Context, owner string, limit int) ([]*model.NFTHolding, error)
 	RetrieveBlocks(ctx context.Context)
+	RepairTransactions(ctx context.Context)
+	Backfill(ctx context.Context, fromNum uint64)
+	DecodeLogs(ctx context.Context)
+	SubscribeBlocks(ch chan<- *model.BlockEvent) event.Subscription
+	SubscribePendingTransactions(ctx context.Context, ch chan<- *model.Transaction) (event.Subscription, error)
 }
 
-var ErrNotFound = errors.New("not found")
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrReorgTooDeep = errors.New("reorg is deeper than the max reorg depth")
+	ErrChainChanged = errors.New("chain changed while re-ingesting blocks")
+	ErrInvalidABI   = errors.New("abi is invalid")
+	ErrNoSubscribe  = errors.New("no endpoint supports subscriptions")
+)
 
 type service struct {
-	ec   *ethclient.Client
-	repo repo.Repo
+	ec          *node.Pool
+	signer      types.Signer
+	subscribe   bool
+	trace       bool
+	batchSize   int
+	concurrency int
+	repo        repo.Repo
+
+	// safe and finalized heads, loaded from the checkpoints until the node
+	// is first asked for them
+	finalityMu     sync.RWMutex
+	finalityLoaded bool
+	safeNum        uint64
+	finalizedNum   uint64
+
+	// blocks announced to the subscribers, once the first head is known
+	blockFeed    event.Feed
+	announceMu   sync.Mutex
+	announcing   bool
+	announcedNum uint64
 }
 
 func New(repo repo.Repo) EthereumService {
-	ec, err := ethclient.Dial(os.Getenv("RPC_ENDPOINT"))
+	// RPC_ENDPOINT lists one or more comma-separated upstream endpoints
+	endpoints := strings.Split(os.Getenv("RPC_ENDPOINT"), ",")
+	ec, err := node.Dial(endpoints, envInt("RPC_QUORUM", defaultQuorum))
 	if err != nil {
-		log.Fatalf("ethclient.Dial failed: %+v", err)
+		log.Fatalf("node.Dial failed: %+v", err)
+	}
+	// the pool starts before any endpoint is up, so the chain ID is asked
+	// until one is
+	chainID, err := ec.ChainID(context.Background())
+	for err != nil {
+		log.Printf("ChainID failed: %+v", err)
+		time.Sleep(chainIDInterval)
+		chainID, err = ec.ChainID(context.Background())
+	}
+	return &service{
+		ec:     ec,
+		signer: types.LatestSignerForChainID(chainID),
+		// websocket and IPC endpoints support subscriptions, HTTP is polled
+		subscribe:   ec.CanSubscribe(),
+		trace:       os.Getenv("TRACE_INTERNAL_TRANSACTIONS") == "true",
+		batchSize:   envInt("RPC_BATCH_SIZE", defaultBatchSize),
+		concurrency: envInt("RPC_CONCURRENCY", defaultConcurrency),
+		repo:        repo,
+	}
+}
+
+// envInt reads a positive integer from the environment variable key, or
+// returns def when it is not set.
+func envInt(key string, def int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
 	}
-	return &service{ec: ec, repo: repo}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		log.Fatalf("%s is invalid: %q", key, value)
+	}
+	return n
 }
 
-const unstableBlockCount = 20
+const (
+	unstableBlockCount = 20
+	maxReorgDepth      = 128
+	repairBatchSize    = 100
+	repairInterval     = time.Minute
+	backfillBatchSize  = 100
+	backfillInterval   = time.Second * 3
+	backfillCheckpoint = "backfill"
+
+	decodeBatchSize      = 10000
+	signatureBatchSize   = 1000
+	decodeLogsCheckpoint = "decode-logs"
+
+	safeCheckpoint      = "safe"
+	finalizedCheckpoint = "finalized"
+
+	defaultBatchSize   = 20
+	defaultConcurrency = 4
+	defaultQuorum      = 1
+
+	maxBlockRange = 1024
+
+	pollInterval        = time.Second * 3
+	resubscribeInterval = time.Second * 3
+	chainIDInterval     = time.Second * 5
+)
 
 func (s *service) RetrieveBlocks(ctx context.Context) {
+	heads := make(chan uint64, 1)
+	if s.subscribe {
+		go s.subscribeHeads(ctx, heads)
+	} else {
+		go s.pollHeads(ctx, heads)
+	}
 
 	limit := 0
-	for range time.Tick(time.Second * 3) {
+	for blockNumber := range heads {
 		if limit < 1000 {
 			limit += 100
 		}
 
+		err := s.repo.SetBlockNumber(ctx, blockNumber)
+		if err != nil {
+			log.Printf("repo.SetBlockNumber failed: %+v", err)
+			continue
+		}
+		s.startAnnouncing(blockNumber)
+
+		blocks, err := s.ListLastestBlocks(ctx, limit, model.FinalityLatest, nil)
+		if err != nil {
+			log.Printf("ListLastestBlocks failed: %v\n", err)
+			continue
+		}
+
+		if err := s.checkReorg(ctx, blockNumber, blocks); err != nil {
+			log.Printf("checkReorg failed: %v\n", err)
+		}
+
+		if err := s.updateFinality(ctx, blockNumber); err != nil {
+			log.Printf("updateFinality failed: %v\n", err)
+		}
+	}
+}
+
+// updateFinality follows the safe and finalized heads of the node and marks
+// the stored blocks accordingly. Nodes without these block tags, such as
+// chains without proof of stake, fall back to treating the blocks
+// unstableBlockCount behind head as safe and finalized.
+func (s *service) updateFinality(ctx context.Context, head uint64) error {
+	var fallback uint64
+	if head > unstableBlockCount {
+		fallback = head - unstableBlockCount
+	}
+	safeNum := s.tagNumber(ctx, rpc.SafeBlockNumber, fallback)
+	finalizedNum := s.tagNumber(ctx, rpc.FinalizedBlockNumber, fallback)
+	if safeNum < finalizedNum {
+		safeNum = finalizedNum
+	}
+
+	if err := s.repo.UpdateFinality(safeNum, finalizedNum); err != nil {
+		return err
+	}
+	if err := s.repo.SetCheckpoint(safeCheckpoint, safeNum); err != nil {
+		return err
+	}
+	if err := s.repo.SetCheckpoint(finalizedCheckpoint, finalizedNum); err != nil {
+		return err
+	}
+
+	s.finalityMu.Lock()
+	defer s.finalityMu.Unlock()
+	s.safeNum, s.finalizedNum, s.finalityLoaded = safeNum, finalizedNum, true
+	return nil
+}
+
+// tagNumber returns the number of the block the node reports for tag, or
+// fallback when the node does not support it.
+func (s *service) tagNumber(ctx context.Context, tag rpc.BlockNumber, fallback uint64) uint64 {
+	header, err := s.ec.HeaderByNumber(ctx, big.NewInt(tag.Int64()))
+	if err != nil {
+		return fallback
+	}
+	return header.Number.Uint64()
+}
+
+// finalityHeads returns the safe and finalized heads.
+func (s *service) finalityHeads() (safeNum, finalizedNum uint64) {
+	s.finalityMu.RLock()
+	if s.finalityLoaded {
+		defer s.finalityMu.RUnlock()
+		return s.safeNum, s.finalizedNum
+	}
+	s.finalityMu.RUnlock()
+
+	for _, head := range []struct {
+		name string
+		num  *uint64
+	}{{safeCheckpoint, &safeNum}, {finalizedCheckpoint, &finalizedNum}} {
+		checkpoint, err := s.repo.GetCheckpoint(head.name)
+		if err == nil {
+			*head.num = checkpoint.BlockNum
+		} else if err != repo.ErrNotFound {
+			log.Printf("repo.GetCheckpoint failed: %+v", err)
+		}
+	}
+	return safeNum, finalizedNum
+}
+
+// blockFinality returns the finality of the block numbered num.
+func (s *service) blockFinality(num uint64) string {
+	safeNum, finalizedNum := s.finalityHeads()
+	switch {
+	case num <= finalizedNum:
+		return model.FinalityFinalized
+	case num <= safeNum:
+		return model.FinalitySafe
+	default:
+		return model.FinalityLatest
+	}
+}
+
+// setFinality sets the current finality on blocks, which may be read from a
+// cache that predates it.
+func (s *service) setFinality(blocks ...*model.Block) {
+	for _, block := range blocks {
+		if block != nil {
+			block.Finality = s.blockFinality(block.BlockNum)
+		}
+	}
+}
+
+// setTxFinality sets the finality of the block that includes tx, which is
+// known from its receipt.
+func (s *service) setTxFinality(tx *model.Transaction) {
+	tx.Finality = model.FinalityLatest
+	if tx.Receipt != nil {
+		tx.Finality = s.blockFinality(tx.Receipt.BlockNum)
+	}
+}
+
+// headNumber returns the number of the newest block at finality.
+func (s *service) headNumber(ctx context.Context, finality string) (uint64, error) {
+	safeNum, finalizedNum := s.finalityHeads()
+	switch finality {
+	case model.FinalitySafe:
+		return safeNum, nil
+	case model.FinalityFinalized:
+		return finalizedNum, nil
+	default:
+		return s.RetrieveBlockNumber(ctx)
+	}
+}
+
+// pollHeads sends the head block number every pollInterval.
+func (s *service) pollHeads(ctx context.Context, heads chan uint64) {
+	for range time.Tick(pollInterval) {
 		blockNumber, err := s.ec.BlockNumber(ctx)
 		if err != nil {
 			log.Printf("BlockNumber failed: %v\n", err)
 			continue
 		}
-		err = s.repo.SetBlockNumber(ctx, blockNumber)
+		sendHead(heads, blockNumber)
+	}
+}
+
+// subscribeHeads sends the number of every new head from a newHeads
+// subscription. When the subscription drops it resubscribes and sends the
+// current head, so the heights missed in between are caught up.
+func (s *service) subscribeHeads(ctx context.Context, heads chan uint64) {
+	for ; ; time.Sleep(resubscribeInterval) {
+		headers := make(chan *types.Header)
+		sub, err := s.ec.SubscribeNewHead(ctx, headers)
 		if err != nil {
-			log.Printf("repo.SetBlockNumber failed: %+v", err)
+			log.Printf("SubscribeNewHead failed: %v\n", err)
 			continue
 		}
 
-		blocks, err := s.ListLastestBlocks(ctx, limit)
+		blockNumber, err := s.ec.BlockNumber(ctx)
 		if err != nil {
-			log.Printf("ListLastestBlocks failed: %v\n", err)
+			log.Printf("BlockNumber failed: %v\n", err)
+		} else {
+			sendHead(heads, blockNumber)
+		}
+
+	loop:
+		for {
+			select {
+			case header := <-headers:
+				sendHead(heads, header.Number.Uint64())
+			case err := <-sub.Err():
+				log.Printf("newHeads subscription dropped: %v\n", err)
+				break loop
+			}
+		}
+		sub.Unsubscribe()
+	}
+}
+
+// sendHead replaces any head that has not been processed yet, as only the
+// latest head matters to RetrieveBlocks.
+func sendHead(heads chan uint64, blockNumber uint64) {
+	select {
+	case <-heads:
+	default:
+	}
+	heads <- blockNumber
+}
+
+// checkReorg looks for a stored block whose parent hash does not match the
+// block stored before it and, if there is one, replaces the stale branch.
+func (s *service) checkReorg(ctx context.Context, head uint64, blocks []*model.Block) error {
+	for num := unstableBlockCount; num > 0; num-- {
+		if num >= len(blocks) || blocks[num-1] == nil || blocks[num] == nil {
 			continue
 		}
+		if blocks[num-1].ParentHash != blocks[num].BlockHash {
+			return s.handleReorg(ctx, blocks[num-1].BlockNum, head)
+		}
+	}
+	return nil
+}
+
+// handleReorg walks back from forkNum to the last stored block that is still
+// on the canonical chain, then re-ingests the new branch up to head. The stale
+// blocks are orphaned by the same database transaction that stores the new
+// branch.
+func (s *service) handleReorg(ctx context.Context, forkNum, head uint64) error {
+	ancestor := forkNum - 1
+	var ancestorHash string
+	for depth := 0; ancestor > 0; depth++ {
+		if depth == maxReorgDepth {
+			return ErrReorgTooDeep
+		}
+		stored, err := s.repo.GetBlock(ancestor)
+		if err == repo.ErrNotFound {
+			break
+		}
+		if err != nil {
+			log.Printf("repo.GetBlock failed: %+v", err)
+			return err
+		}
+		header, err := s.ec.HeaderByNumber(ctx, new(big.Int).SetUint64(ancestor))
+		if err != nil {
+			log.Printf("HeaderByNumber failed: %+v", err)
+			return err
+		}
+		if stored.BlockHash == header.Hash().String() {
+			ancestorHash = stored.BlockHash
+			break
+		}
+		ancestor--
+	}
+	log.Printf("reorg detected, re-ingesting blocks %d to %d", ancestor+1, head)
+
+	if ancestor >= head {
+		return nil
+	}
+	blocks, err := s.fetchBlocks(ctx, blockRange(ancestor+1, head))
+	if err != nil {
+		return err
+	}
+	parentHash := ancestorHash
+	for _, block := range blocks {
+		if parentHash != "" && block.ParentHash != parentHash {
+			return ErrChainChanged
+		}
+		parentHash = block.BlockHash
+	}
+	return s.storeBlocks(ctx, blocks...)
+}
+
+// storeBlocks persists new blocks, drops the caches of the blocks and
+// transactions they reorged out and caches the new blocks.
+func (s *service) storeBlocks(ctx context.Context, blocks ...*model.Block) error {
+	s.setFinality(blocks...)
+	orphaned, err := s.repo.CreateBlocks(blocks...)
+	if err != nil {
+		return err
+	}
+
+	s.dropOrphanedCache(ctx, orphaned)
+
+	if err := s.repo.SetBlockCache(ctx, blocks...); err != nil {
+		log.Printf("repo.SetBlockCache failed: %+v", err)
+	}
+
+	s.announceBlocks(orphaned, blocks)
+	return nil
+}
 
-		for num := unstableBlockCount; num > 0; num-- {
-			if blocks[num-1].ParentHash != blocks[num].BlockHash {
-				if err := s.repo.DelBlockCache(ctx, blocks[:num]...); err != nil {
-					log.Printf("repo.DelBlockCache failed: %v\n", err)
+// prepareEvent fills in the transactions of the block the way GetTransaction
+// returns them, before it is shared with the subscribers.
+func prepareEvent(block *model.Block) {
+	for _, tx := range block.Transactions {
+		tx.Finality = block.Finality
+		if tx.Receipt != nil {
+			tx.Logs = tx.Receipt.Logs
+		}
+	}
+}
+
+// SubscribeBlocks sends the blocks added to the chain from the head on, and
+// the blocks removed by reorgs, to ch. The blocks carry their transactions,
+// with their receipts and logs. The sender waits for ch to take each event,
+// and the events are shared, so they must not be modified.
+func (s *service) SubscribeBlocks(ch chan<- *model.BlockEvent) event.Subscription {
+	return s.blockFeed.Subscribe(ch)
+}
+
+// SubscribePendingTransactions sends the transactions entering the pool of an
+// upstream endpoint to ch. Pending transactions are not indexed, so every
+// subscriber has a subscription of its own on the endpoint, which drops it
+// with rpc.ErrSubscriptionQueueOverflow when it falls behind.
+func (s *service) SubscribePendingTransactions(ctx context.Context, ch chan<- *model.Transaction) (event.Subscription, error) {
+	if !s.subscribe {
+		return nil, ErrNoSubscribe
+	}
+	txs := make(chan *types.Transaction)
+	sub, err := s.ec.SubscribePendingTransactions(ctx, txs)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case tx := <-txs:
+				// a transaction signed for another chain has no sender here
+				txn, err := model.NewTransaction(tx, s.signer)
+				if err != nil {
+					continue
 				}
-				if _, err := s.ListLastestBlocks(ctx, unstableBlockCount); err != nil {
-					log.Printf("ListLastestBlocks failed: %v\n", err)
+				select {
+				case ch <- txn:
+				case <-quit:
+					return nil
 				}
-				break
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
 			}
 		}
+	}), nil
+}
+
+// startAnnouncing starts announcing the blocks stored above the first head,
+// which are the ones the head loop retrieves.
+func (s *service) startAnnouncing(head uint64) {
+	s.announceMu.Lock()
+	defer s.announceMu.Unlock()
+	if !s.announcing && head > 0 {
+		s.announcing = true
+		s.announcedNum = head - 1
 	}
 }
 
-func (s *service) ListLastestBlocks(ctx context.Context, limit int) ([]*model.Block, error) {
-	blockNumber, err := s.RetrieveBlockNumber(ctx)
+// announceBlocks sends the orphaned blocks as removed, newest first, and then
+// the stored blocks above the last announced one, oldest first. Older blocks,
+// such as backfilled ones, are not news.
+func (s *service) announceBlocks(orphaned, blocks []*model.Block) {
+	s.announceMu.Lock()
+	defer s.announceMu.Unlock()
+	if !s.announcing {
+		return
+	}
+
+	orphaned = append([]*model.Block(nil), orphaned...)
+	sort.Slice(orphaned, func(i, j int) bool {
+		return orphaned[i].BlockNum > orphaned[j].BlockNum
+	})
+	for _, block := range orphaned {
+		prepareEvent(block)
+		s.blockFeed.Send(&model.BlockEvent{Block: block, Removed: true})
+		if block.BlockNum <= s.announcedNum {
+			s.announcedNum = block.BlockNum - 1
+		}
+	}
+
+	blocks = append([]*model.Block(nil), blocks...)
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].BlockNum < blocks[j].BlockNum
+	})
+	for _, block := range blocks {
+		if block.BlockNum > s.announcedNum {
+			prepareEvent(block)
+			s.blockFeed.Send(&model.BlockEvent{Block: block})
+			s.announcedNum = block.BlockNum
+		}
+	}
+}
+
+func (s *service) dropOrphanedCache(ctx context.Context, orphaned []*model.Block) {
+	if len(orphaned) == 0 {
+		return
+	}
+	var txHashes []string
+	for _, b := range orphaned {
+		txHashes = append(txHashes, b.TxHash...)
+	}
+	if err := s.repo.DelBlockCache(ctx, orphaned...); err != nil {
+		log.Printf("repo.DelBlockCache failed: %+v", err)
+	}
+	if len(txHashes) > 0 {
+		if err := s.repo.DelTxCache(ctx, txHashes...); err != nil {
+			log.Printf("repo.DelTxCache failed: %+v", err)
+		}
+	}
+}
+
+// Backfill ingests the blocks from fromNum up to the unstable blocks below the
+// head, recording its progress in a checkpoint so that it resumes where it
+// stopped after a restart. Once it has caught up it keeps following the head
+// behind the unstable blocks, so the history stays free of gaps.
+func (s *service) Backfill(ctx context.Context, fromNum uint64) {
+	next, err := s.backfillStart(fromNum)
+	if err != nil {
+		log.Printf("repo.GetCheckpoint failed: %+v", err)
+		return
+	}
+	log.Printf("backfill starts from block %d", next)
+
+	for {
+		var ok bool
+		if next, ok = s.backfillBatch(ctx, next); !ok {
+			time.Sleep(backfillInterval)
+		}
+	}
+}
+
+// backfillStart returns the block to backfill from, the one after the
+// checkpoint when a previous run got past fromNum.
+func (s *service) backfillStart(fromNum uint64) (uint64, error) {
+	checkpoint, err := s.repo.GetCheckpoint(backfillCheckpoint)
+	if err == repo.ErrNotFound {
+		return fromNum, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if checkpoint.BlockNum >= fromNum {
+		return checkpoint.BlockNum + 1, nil
+	}
+	return fromNum, nil
+}
+
+// backfillBatch stores the next batch of blocks from next and advances the
+// checkpoint past it, returning the block to continue from. It returns false
+// when there is nothing to backfill yet or the batch failed, leaving the
+// checkpoint where it was.
+func (s *service) backfillBatch(ctx context.Context, next uint64) (uint64, bool) {
+	// only safe blocks are backfilled, the newer ones are followed by
+	// RetrieveBlocks
+	toNum, _ := s.finalityHeads()
+	if toNum == 0 || next > toNum {
+		return next, false
+	}
+
+	if toNum-next >= backfillBatchSize {
+		toNum = next + backfillBatchSize - 1
+	}
+	if err := s.backfillBlocks(ctx, next, toNum); err != nil {
+		log.Printf("backfillBlocks %d to %d failed: %+v", next, toNum, err)
+		return next, false
+	}
+	if err := s.repo.SetCheckpoint(backfillCheckpoint, toNum); err != nil {
+		log.Printf("repo.SetCheckpoint failed: %+v", err)
+		return next, false
+	}
+	return toNum + 1, true
+}
+
+// backfillBlocks stores the blocks between fromNum and toNum without caching
+// them, as they are rarely read compared to the latest blocks.
+func (s *service) backfillBlocks(ctx context.Context, fromNum, toNum uint64) error {
+	blocks, err := s.fetchBlocks(ctx, blockRange(fromNum, toNum))
+	if err != nil {
+		return err
+	}
+	s.setFinality(blocks...)
+	orphaned, err := s.repo.CreateBlocks(blocks...)
+	if err != nil {
+		return err
+	}
+	s.dropOrphanedCache(ctx, orphaned)
+	s.announceBlocks(orphaned, nil)
+	return nil
+}
+
+// ListLastestBlocks lists up to limit blocks, newest first, below the head of
+// the finality. With a cursor, the blocks listed are the ones older than the
+// cursor, or newer when it walks forward.
+func (s *service) ListLastestBlocks(ctx context.Context, limit int, finality string, cursor *model.Cursor) ([]*model.Block, error) {
+	blockNumber, err := s.headNumber(ctx, finality)
+	if err != nil {
+		return nil, err
+	}
+	toNumber := blockNumber
+	switch {
+	case cursor == nil:
+	case cursor.Forward:
+		// the page holds the blocks right above the cursor, short of a full
+		// page near the head rather than repeating the blocks below it
+		if cursor.BlockNum >= blockNumber {
+			return []*model.Block{}, nil
+		}
+		return s.listBlocks(ctx, cursor.BlockNum+1, min(cursor.BlockNum+uint64(limit), blockNumber))
+	default:
+		if cursor.BlockNum == 0 {
+			return []*model.Block{}, nil
+		}
+		toNumber = min(cursor.BlockNum-1, blockNumber)
+	}
+	if uint64(limit) > toNumber+1 {
+		limit = int(toNumber + 1)
+	}
+	return s.listBlocks(ctx, toNumber-uint64(limit)+1, toNumber)
+}
+
+// ListBlocks lists the blocks from fromNum to toNum, newest first. A nil toNum
+// stands for the head of the finality, and at most maxBlockRange blocks from
+// fromNum are listed.
+func (s *service) ListBlocks(ctx context.Context, fromNum uint64, toNum *uint64, finality string) ([]*model.Block, error) {
+	blockNumber, err := s.headNumber(ctx, finality)
 	if err != nil {
 		return nil, err
 	}
-	fromNumber := blockNumber - uint64(limit) + 1
 	toNumber := blockNumber
+	if toNum != nil {
+		toNumber = min(*toNum, blockNumber)
+	}
+	if fromNum > toNumber {
+		return []*model.Block{}, nil
+	}
+	toNumber = min(toNumber, fromNum+maxBlockRange-1)
+	return s.listBlocks(ctx, fromNum, toNumber)
+}
+
+// listBlocks lists the blocks from fromNumber to toNumber, newest first, from
+// the cache or else the node.
+func (s *service) listBlocks(ctx context.Context, fromNumber, toNumber uint64) ([]*model.Block, error) {
+	if fromNumber > toNumber {
+		return []*model.Block{}, nil
+	}
+	limit := int(toNumber - fromNumber + 1)
 
 	savedBlocks, err := s.repo.ListBlocks(ctx, fromNumber, toNumber)
 	if err != nil {
@@ -95,55 +700,46 @@ func (s *service) ListLastestBlocks(ctx context.Context, limit int) ([]*model.Bl
 		return nil, err
 	}
 	if len(savedBlocks) == limit {
+		s.setFinality(savedBlocks...)
 		return savedBlocks, nil
 	}
 
-	var wg sync.WaitGroup
-
 	blocks := make([]*model.Block, limit)
 
-	var newCount uint64
-	newBlocks := make(chan *model.Block, limit)
+	var missing []uint64
 	var index int
-	for num := toNumber; num >= fromNumber; num-- {
+	for i := 0; i < limit; i++ {
+		num := toNumber - uint64(i)
 		if index < len(savedBlocks) && savedBlocks[index].BlockNum == num {
 			blocks[toNumber-num] = savedBlocks[index]
 			index++
 			continue
 		}
-
-		wg.Add(1)
-		go func(num uint64) {
-			defer wg.Done()
-			block, isNew, err := s.RetrieveBlock(ctx, num)
-			if err != nil {
-				log.Printf("BlockByNumber failed: %+v", err)
-				return
-			}
-			blocks[toNumber-num] = block
-			if isNew {
-				atomic.AddUint64(&newCount, 1)
-				newBlocks <- block
-			}
-		}(num)
+		missing = append(missing, num)
+	}
+	if len(missing) == 0 {
+		return blocks, nil
 	}
-	wg.Wait()
-	close(newBlocks)
 
-	if newCount > 0 {
-		blocksToCreate := make([]*model.Block, 0, newCount)
-		for b := range newBlocks {
-			blocksToCreate = append(blocksToCreate, b)
+	newBlocks, err := s.fetchBlocks(ctx, missing)
+	if err != nil {
+		log.Printf("fetchBlocks failed: %+v", err)
+	}
+	blocksToCreate := make([]*model.Block, 0, len(newBlocks))
+	for i, block := range newBlocks {
+		if block == nil {
+			continue
 		}
-		err = s.repo.CreateBlocks(blocksToCreate...)
+		blocks[toNumber-missing[i]] = block
+		blocksToCreate = append(blocksToCreate, block)
+	}
+
+	if len(blocksToCreate) > 0 {
+		err = s.storeBlocks(ctx, blocksToCreate...)
 		if err != nil {
-			log.Printf("db.Create failed: %+v", err)
+			log.Printf("storeBlocks failed: %+v", err)
 			return nil, err
 		}
-		err = s.repo.SetBlockCache(ctx, blocksToCreate...)
-		if err != nil {
-			log.Printf("repo.SetBlockCache failed: %+v", err)
-		}
 	}
 
 	return blocks, nil
@@ -163,18 +759,136 @@ func (s *service) GetBlock(ctx context.Context, num uint64) (*model.Block, error
 		return nil, err
 	}
 	if isNew {
-		err = s.repo.CreateBlocks(block)
+		err = s.storeBlocks(ctx, block)
 		if err != nil {
-			log.Printf("repo.CreateBlock failed: %+v", err)
+			log.Printf("storeBlocks failed: %+v", err)
+		}
+	}
+	s.setFinality(block)
+	return block, nil
+}
+
+// GetBlockByTime returns the last stored block mined at or before the
+// timestamp, or with before unset, the first one mined at or after it.
+func (s *service) GetBlockByTime(ctx context.Context, timestamp uint64, before bool) (*model.Block, error) {
+	block, err := s.repo.GetBlockByTime(timestamp, before)
+	if err == repo.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		log.Printf("repo.GetBlockByTime failed: %+v", err)
+		return nil, err
+	}
+	s.setFinality(block)
+	return block, nil
+}
+
+// ListBlocksByNumber lists the stored blocks of the numbers, without their
+// transactions. Blocks not stored yet are left out.
+func (s *service) ListBlocksByNumber(ctx context.Context, nums []uint64) ([]*model.Block, error) {
+	blocks, err := s.repo.ListBlocksByNumber(nums)
+	if err != nil {
+		log.Printf("repo.ListBlocksByNumber failed: %+v", err)
+		return nil, err
+	}
+	s.setFinality(blocks...)
+	return blocks, nil
+}
+
+// GetBlockByHash returns the canonical block with the hash or, with orphaned,
+// a block that was reorged out. The height of a block that is not stored yet
+// is looked up on the node, and the block at that height must still have the
+// hash.
+func (s *service) GetBlockByHash(ctx context.Context, hash string, orphaned bool) (*model.Block, error) {
+	var num uint64
+	stored, err := s.repo.GetBlockByHash(hash, orphaned)
+	switch {
+	case err == nil && stored.Orphaned:
+		return stored, nil
+	case err == nil:
+		num = stored.BlockNum
+	case err == repo.ErrNotFound:
+		header, err := s.ec.HeaderByHash(ctx, common.HexToHash(hash))
+		if err == ethereum.NotFound {
+			return nil, ErrNotFound
 		}
-		err = s.repo.SetBlockCache(ctx, block)
 		if err != nil {
-			log.Printf("repo.SetBlockCache failed: %+v", err)
+			log.Printf("HeaderByHash failed: %+v", err)
+			return nil, err
 		}
+		num = header.Number.Uint64()
+	default:
+		log.Printf("repo.GetBlockByHash failed: %+v", err)
+		return nil, err
+	}
+
+	block, err := s.GetBlock(ctx, num)
+	if err != nil {
+		return nil, err
+	}
+	if block.BlockHash != hash {
+		return nil, ErrNotFound
 	}
 	return block, nil
 }
 
+// GetBlockTransactions returns the transactions of the block with their
+// receipts, like GetTransaction does one by one. They are read from the
+// database at once, unless some are not stored completely yet, and decoded
+// together. The transactions of an orphaned block are returned as they were
+// kept when it was reorged out, without receipts or logs.
+func (s *service) GetBlockTransactions(ctx context.Context, block *model.Block) ([]*model.Transaction, error) {
+	var txs []*model.Transaction
+	var err error
+	if block.Orphaned {
+		txs, err = s.repo.ListOrphanedTransactions(block.BlockHash)
+		if err != nil {
+			log.Printf("repo.ListOrphanedTransactions failed: %+v", err)
+			return nil, err
+		}
+	} else if txs, err = s.listBlockTransactions(ctx, block); err != nil {
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		s.setTxFinality(tx)
+	}
+	s.decodeTransactions(txs...)
+	return txs, nil
+}
+
+// listBlockTransactions reads the transactions of the block from the
+// database, or one by one as getTransaction does when some are not stored
+// completely yet.
+func (s *service) listBlockTransactions(ctx context.Context, block *model.Block) ([]*model.Transaction, error) {
+	txs, err := s.repo.ListBlockTransactions(block.BlockNum)
+	if err != nil {
+		log.Printf("repo.ListBlockTransactions failed: %+v", err)
+		return nil, err
+	}
+	if len(txs) == len(block.TxHash) {
+		for i, tx := range txs {
+			if tx.Data == "" || tx.Gas == 0 {
+				// stored before these fields were, getTransaction repairs it
+				if txs[i], err = s.getTransaction(ctx, tx.TxHash); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			tx.Logs = tx.Receipt.Logs
+		}
+		return txs, nil
+	}
+
+	txs = make([]*model.Transaction, len(block.TxHash))
+	for i, txHash := range block.TxHash {
+		if txs[i], err = s.getTransaction(ctx, txHash); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
 func (s *service) RetrieveBlockNumber(ctx context.Context) (uint64, error) {
 	num, err := s.repo.GetBlockNumber(ctx)
 	if err == nil {
@@ -264,23 +978,364 @@ func (s *service) RetrieveBlock(ctx context.Context, num uint64) (*model.Block,
 		break
 	}
 
-	b, err := s.ec.BlockByNumber(ctx, big.NewInt(int64(num)))
+	block, err = s.fetchBlock(ctx, num)
 	if err != nil {
-		if err == ethereum.NotFound {
-			return nil, false, ErrNotFound
-		}
-		log.Printf("BlockByNumber failed: %+v", err)
 		return nil, false, err
 	}
-	block = model.NewBlock(b)
-	block.TxHash = make([]string, len(block.Transactions))
-	for i := range block.Transactions {
-		block.TxHash[i] = block.Transactions[i].TxHash
-	}
 	return block, true, nil
 }
 
+// fetchBlock retrieves the block and the receipts of its transactions from the
+// node.
+func (s *service) fetchBlock(ctx context.Context, num uint64) (*model.Block, error) {
+	blocks, err := s.fetchBlocks(ctx, []uint64{num})
+	if err != nil {
+		if err != ErrNotFound {
+			log.Printf("fetchBlocks failed: %+v", err)
+		}
+		return nil, err
+	}
+	return blocks[0], nil
+}
+
+// fetchBlocks retrieves the blocks and the receipts of their transactions with
+// batch requests of at most batchSize calls, running at most concurrency
+// batches at a time. A block that could not be retrieved is left nil and the
+// first error is returned along with the other blocks.
+func (s *service) fetchBlocks(ctx context.Context, nums []uint64) ([]*model.Block, error) {
+	raws := make([]json.RawMessage, len(nums))
+	errs := make([]error, len(nums))
+	s.runBatches(len(nums), func(from, to int) {
+		batch := make([]rpc.BatchElem, to-from)
+		for i := range batch {
+			batch[i] = rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []interface{}{hexutil.EncodeUint64(nums[from+i]), true},
+				Result: &raws[from+i],
+			}
+		}
+		err := s.ec.BatchCallContext(ctx, batch)
+		for i := range batch {
+			if err != nil {
+				errs[from+i] = err
+			} else {
+				errs[from+i] = batch[i].Error
+			}
+		}
+	})
+
+	blocks := make([]*model.Block, len(nums))
+	for i, raw := range raws {
+		if errs[i] != nil {
+			continue
+		}
+		b, err := parseBlock(raw)
+		if err == nil {
+			blocks[i], err = model.NewBlock(b, s.signer)
+		}
+		errs[i] = err
+	}
+	s.checkQuorum(ctx, nums, blocks, errs)
+
+	s.retrieveReceipts(ctx, blocks, errs)
+	if s.trace {
+		s.retrieveTraces(ctx, blocks, errs)
+	}
+
+	var firstErr error
+	for i, block := range blocks {
+		if errs[i] != nil {
+			blocks[i] = nil
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			continue
+		}
+		block.TxHash = make([]string, len(block.Transactions))
+		for j := range block.Transactions {
+			block.TxHash[j] = block.Transactions[j].TxHash
+		}
+	}
+	return blocks, firstErr
+}
+
+// checkQuorum fails the blocks whose hash the quorum of endpoints does not
+// agree on yet, as a batch is fetched from a single endpoint. The blocks that
+// already failed in errs are skipped.
+func (s *service) checkQuorum(ctx context.Context, nums []uint64, blocks []*model.Block, errs []error) {
+	var indexes []int
+	var checkNums []uint64
+	var hashes []common.Hash
+	for i, block := range blocks {
+		if errs[i] == nil {
+			indexes = append(indexes, i)
+			checkNums = append(checkNums, nums[i])
+			hashes = append(hashes, common.HexToHash(block.BlockHash))
+		}
+	}
+	for j, err := range s.ec.CheckHashes(ctx, checkNums, hashes) {
+		errs[indexes[j]] = err
+	}
+}
+
+// parseBlock decodes an eth_getBlockByNumber result with full transactions.
+func parseBlock(raw json.RawMessage) (*types.Block, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, ErrNotFound
+	}
+	var header types.Header
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, err
+	}
+	var body struct {
+		Transactions []*types.Transaction `json:"transactions"`
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, err
+	}
+	return types.NewBlockWithHeader(&header).WithBody(body.Transactions, nil), nil
+}
+
+// retrieveReceipts fetches the receipts of all transactions in the blocks with
+// batch requests and attaches them to the transactions. A failure is recorded
+// in errs at the index of the block.
+func (s *service) retrieveReceipts(ctx context.Context, blocks []*model.Block, errs []error) {
+	type blockTx struct {
+		block int
+		tx    *model.Transaction
+	}
+	var txs []blockTx
+	for i, block := range blocks {
+		if block == nil {
+			continue
+		}
+		for _, tx := range block.Transactions {
+			txs = append(txs, blockTx{block: i, tx: tx})
+		}
+	}
+
+	receipts := make([]*types.Receipt, len(txs))
+	txErrs := make([]error, len(txs))
+	s.runBatches(len(txs), func(from, to int) {
+		batch := make([]rpc.BatchElem, to-from)
+		for i := range batch {
+			batch[i] = rpc.BatchElem{
+				Method: "eth_getTransactionReceipt",
+				Args:   []interface{}{txs[from+i].tx.TxHash},
+				Result: &receipts[from+i],
+			}
+		}
+		err := s.ec.BatchCallContext(ctx, batch)
+		for i := range batch {
+			if err != nil {
+				txErrs[from+i] = err
+			} else {
+				txErrs[from+i] = batch[i].Error
+			}
+		}
+	})
+
+	for i, t := range txs {
+		err := txErrs[i]
+		if err == nil && receipts[i] == nil {
+			err = ErrNotFound
+		}
+		if err != nil {
+			if errs[t.block] == nil {
+				errs[t.block] = err
+			}
+			continue
+		}
+		t.tx.Receipt = model.NewReceipt(receipts[i])
+		t.tx.Logs = t.tx.Receipt.Logs
+	}
+}
+
+// callFrame is a call in the output of the callTracer.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to"`
+	Value   *hexutil.Big    `json:"value"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Error   string          `json:"error"`
+	Calls   []callFrame     `json:"calls"`
+}
+
+// retrieveTraces traces the transactions of blocks with the callTracer and
+// attaches the calls they make as internal transactions. Tracing is optional,
+// so a block that cannot be traced, for instance by a node without the debug
+// API, is logged and stored without internal transactions rather than failed.
+// The blocks that already failed in errs are skipped.
+func (s *service) retrieveTraces(ctx context.Context, blocks []*model.Block, errs []error) {
+	var indexes []int
+	for i, block := range blocks {
+		if block != nil && errs[i] == nil && len(block.Transactions) > 0 {
+			indexes = append(indexes, i)
+		}
+	}
+
+	type txTrace struct {
+		Result *callFrame `json:"result"`
+		Error  string     `json:"error"`
+	}
+	traces := make([][]txTrace, len(indexes))
+	traceErrs := make([]error, len(indexes))
+	s.runBatches(len(indexes), func(from, to int) {
+		batch := make([]rpc.BatchElem, to-from)
+		for i := range batch {
+			batch[i] = rpc.BatchElem{
+				Method: "debug_traceBlockByNumber",
+				Args: []interface{}{
+					hexutil.EncodeUint64(blocks[indexes[from+i]].BlockNum),
+					map[string]string{"tracer": "callTracer"},
+				},
+				Result: &traces[from+i],
+			}
+		}
+		err := s.ec.BatchCallContext(ctx, batch)
+		for i := range batch {
+			if err != nil {
+				traceErrs[from+i] = err
+			} else {
+				traceErrs[from+i] = batch[i].Error
+			}
+		}
+	})
+
+	for i, index := range indexes {
+		block := blocks[index]
+		err := traceErrs[i]
+		if err == nil && len(traces[i]) != len(block.Transactions) {
+			err = ErrNotFound
+		}
+		for j := 0; err == nil && j < len(traces[i]); j++ {
+			if traces[i][j].Error != "" {
+				err = errors.New(traces[i][j].Error)
+			}
+		}
+		if err != nil {
+			log.Printf("debug_traceBlockByNumber %d failed, storing it without internal transactions: %v", block.BlockNum, err)
+			continue
+		}
+		for j, tx := range block.Transactions {
+			tx.InternalTransactions = nil
+			if frame := traces[i][j].Result; frame != nil {
+				flattenCalls(tx, frame.Calls, 1)
+			}
+		}
+	}
+}
+
+// flattenCalls appends calls and the calls they make to the internal
+// transactions of tx, depth first.
+func flattenCalls(tx *model.Transaction, calls []callFrame, depth uint) {
+	for _, call := range calls {
+		var toAddr string
+		if call.To != nil {
+			toAddr = call.To.String()
+		}
+		value := "0"
+		if call.Value != nil {
+			value = call.Value.ToInt().String()
+		}
+		tx.InternalTransactions = append(tx.InternalTransactions, model.InternalTransaction{
+			TxHash:   tx.TxHash,
+			Index:    uint(len(tx.InternalTransactions)),
+			BlockNum: tx.BlockNum,
+			Type:     call.Type,
+			FromAddr: call.From.String(),
+			ToAddr:   toAddr,
+			Value:    value,
+			Gas:      uint64(call.Gas),
+			GasUsed:  uint64(call.GasUsed),
+			Depth:    depth,
+			Error:    call.Error,
+		})
+		flattenCalls(tx, call.Calls, depth+1)
+	}
+}
+
+// runBatches splits n calls into batches of batchSize and runs fn for each
+// batch, with at most concurrency batches running at a time.
+func (s *service) runBatches(n int, fn func(from, to int)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, s.concurrency)
+	for from := 0; from < n; from += s.batchSize {
+		to := from + s.batchSize
+		if to > n {
+			to = n
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(from, to int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(from, to)
+		}(from, to)
+	}
+	wg.Wait()
+}
+
+// blockRange lists the block numbers from fromNum to toNum.
+func blockRange(fromNum, toNum uint64) []uint64 {
+	nums := make([]uint64, 0, toNum-fromNum+1)
+	for num := fromNum; num <= toNum; num++ {
+		nums = append(nums, num)
+	}
+	return nums
+}
+
+func (s *service) GetReceipt(ctx context.Context, txHash string) (*model.Receipt, error) {
+	tx, err := s.GetTransaction(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Receipt == nil {
+		// cached before receipts were stored along with the transaction
+		if err := s.repo.DelTxCache(ctx, txHash); err != nil {
+			log.Printf("repo.DelTxCache failed: %v", err)
+			return nil, err
+		}
+		if tx, err = s.GetTransaction(ctx, txHash); err != nil {
+			return nil, err
+		}
+	}
+	return tx.Receipt, nil
+}
+
+// GetTransaction returns the transaction with its finality and, for the
+// contracts with a registered ABI, its decoded call and events. Neither is
+// cached, as both may change.
 func (s *service) GetTransaction(ctx context.Context, txHash string) (*model.Transaction, error) {
+	tx, err := s.getTransaction(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	s.setTxFinality(tx)
+	s.decodeTransactions(tx)
+	return tx, nil
+}
+
+// ListTransactions lists the stored transactions of the hashes with their
+// receipts, in block order. Unlike GetTransaction, transactions not stored yet
+// are left out rather than fetched from the node, and none is decoded.
+func (s *service) ListTransactions(ctx context.Context, txHashes []string) ([]*model.Transaction, error) {
+	txs, err := s.repo.ListTransactions(txHashes)
+	if err != nil {
+		log.Printf("repo.ListTransactions failed: %+v", err)
+		return nil, err
+	}
+	for _, tx := range txs {
+		s.setTxFinality(tx)
+	}
+	return txs, nil
+}
+
+func (s *service) getTransaction(ctx context.Context, txHash string) (*model.Transaction, error) {
 	tx, err := s.repo.GetTxCache(ctx, txHash)
 	if err == nil {
 		if tx.TxHash == "" {
@@ -294,6 +1349,13 @@ func (s *service) GetTransaction(ctx context.Context, txHash string) (*model.Tra
 	}
 
 	tx, err = s.repo.GetTransaction(txHash)
+	if err == nil && (tx.Data == "" || tx.Gas == 0 || tx.R == "") {
+		// a transaction the node no longer holds is served as stored
+		if err := s.repairTransaction(ctx, tx); err != nil && err != ErrNotFound {
+			log.Printf("repairTransaction failed: %+v", err)
+			return nil, err
+		}
+	}
 	if err != nil {
 		if err != repo.ErrNotFound {
 			log.Printf("repo.GetTransaction failed: %+v", err)
@@ -337,29 +1399,36 @@ func (s *service) GetTransaction(ctx context.Context, txHash string) (*model.Tra
 			log.Printf("TransactionByHash failed: %+v", err)
 			return nil, err
 		}
-		tx = model.NewTransaction(txn)
+		tx, err = model.NewTransaction(txn, s.signer)
+		if err != nil {
+			log.Printf("model.NewTransaction failed: %+v", err)
+			return nil, err
+		}
+		// the receipt tells the block, which the transaction is stored with
+		receipt, err := s.ec.TransactionReceipt(ctx, common.HexToHash(txHash))
+		if err != nil {
+			log.Printf("TransactionReceipt failed: %+v", err)
+			return nil, err
+		}
+		tx.Receipt = model.NewReceipt(receipt)
+		tx.BlockNum = tx.Receipt.BlockNum
 		if err := s.repo.CreateTransaction(tx); err != nil {
 			log.Printf("repo.CreateTransaction failed: %v", err)
 		}
 	}
 
-	if tx.Logs == nil {
+	if tx.Receipt == nil {
 		receipt, err := s.ec.TransactionReceipt(ctx, common.HexToHash(txHash))
 		if err != nil {
 			log.Printf("TransactionReceipt failed: %+v", err)
 			return nil, err
 		}
-		tx.Logs = make([]model.Log, len(receipt.Logs))
-		for i, log := range receipt.Logs {
-			tx.Logs[i] = model.Log{
-				Index: log.Index,
-				Data:  common.BytesToHash(log.Data).String(),
-			}
-		}
-		if err := s.repo.UpdateTransactionLogs(tx); err != nil {
-			log.Printf("repo.UpdateTransactionLogs failed: %v", err)
+		tx.Receipt = model.NewReceipt(receipt)
+		if err := s.repo.CreateReceipt(tx.Receipt); err != nil {
+			log.Printf("repo.CreateReceipt failed: %v", err)
 		}
 	}
+	tx.Logs = tx.Receipt.Logs
 
 	if err := s.repo.SetTxCache(ctx, txHash, tx); err != nil {
 		log.Printf("repo.SetTxCache failed: %v", err)
@@ -367,3 +1436,421 @@ func (s *service) GetTransaction(ctx context.Context, txHash string) (*model.Tra
 
 	return tx, nil
 }
+
+// defaultRange sets a missing end of a block range, a nil from or to, to the
+// latest block.
+func (s *service) defaultRange(ctx context.Context, from, to **uint64) error {
+	if *from != nil && *to != nil {
+		return nil
+	}
+	blockNumber, err := s.RetrieveBlockNumber(ctx)
+	if err != nil {
+		log.Printf("RetrieveBlockNumber failed: %+v", err)
+		return err
+	}
+	if *from == nil {
+		*from = &blockNumber
+	}
+	if *to == nil {
+		*to = &blockNumber
+	}
+	return nil
+}
+
+func (s *service) GetLogs(ctx context.Context, filter *model.LogFilter) ([]*model.Log, error) {
+	if err := s.defaultRange(ctx, &filter.FromBlock, &filter.ToBlock); err != nil {
+		return nil, err
+	}
+
+	logs, err := s.repo.ListLogs(filter)
+	if err != nil {
+		log.Printf("repo.ListLogs failed: %+v", err)
+		return nil, err
+	}
+	return logs, nil
+}
+
+func (s *service) GetAddressTransactions(ctx context.Context, filter *model.AddressTxFilter) ([]*model.Transaction, error) {
+	if err := s.defaultRange(ctx, &filter.FromBlock, &filter.ToBlock); err != nil {
+		return nil, err
+	}
+
+	txs, err := s.repo.ListAddressTransactions(filter)
+	if err != nil {
+		log.Printf("repo.ListAddressTransactions failed: %+v", err)
+		return nil, err
+	}
+	for _, tx := range txs {
+		tx.Finality = s.blockFinality(tx.BlockNum)
+	}
+	return txs, nil
+}
+
+func (s *service) GetTokenTransfers(ctx context.Context, filter *model.TokenTransferFilter) ([]*model.TokenTransfer, error) {
+	if err := s.defaultRange(ctx, &filter.FromBlock, &filter.ToBlock); err != nil {
+		return nil, err
+	}
+
+	transfers, err := s.repo.ListTokenTransfers(filter)
+	if err != nil {
+		log.Printf("repo.ListTokenTransfers failed: %+v", err)
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (s *service) GetNFTOwners(ctx context.Context, token, tokenID string) ([]*model.NFTHolding, error) {
+	holdings, err := s.repo.ListNFTOwners(token, tokenID)
+	if err != nil {
+		log.Printf("repo.ListNFTOwners failed: %+v", err)
+		return nil, err
+	}
+	return holdings, nil
+}
+
+func (s *service) GetNFTInventory(ctx context.Context, owner string, limit int) ([]*model.NFTHolding, error) {
+	holdings, err := s.repo.ListNFTInventory(owner, limit)
+	if err != nil {
+		log.Printf("repo.ListNFTInventory failed: %+v", err)
+		return nil, err
+	}
+	return holdings, nil
+}
+
+// DecodeLogs decodes the token and NFT transfers of the logs stored before they were
+// decoded on ingestion, up to the head when it starts. The progress is
+// checkpointed, so a restarted indexer resumes where it stopped.
+func (s *service) DecodeLogs(ctx context.Context) {
+	var next uint64
+	checkpoint, err := s.repo.GetCheckpoint(decodeLogsCheckpoint)
+	if err == nil {
+		next = checkpoint.BlockNum + 1
+	} else if err != repo.ErrNotFound {
+		log.Printf("repo.GetCheckpoint failed: %+v", err)
+		return
+	}
+	head, err := s.RetrieveBlockNumber(ctx)
+	if err != nil {
+		log.Printf("RetrieveBlockNumber failed: %+v", err)
+		return
+	}
+
+	topics := [][]string{{model.TransferTopic, model.TransferSingleTopic, model.TransferBatchTopic}}
+	for next <= head {
+		// skip to the next block with a log to decode
+		first, err := s.repo.ListLogs(&model.LogFilter{Topics: topics, FromBlock: &next, ToBlock: &head, Limit: 1})
+		if err != nil {
+			log.Printf("repo.ListLogs failed: %+v", err)
+			return
+		}
+		toNum := head
+		if len(first) > 0 && first[0].BlockNum+decodeBatchSize-1 < head {
+			toNum = first[0].BlockNum + decodeBatchSize - 1
+		}
+
+		logs, err := s.repo.ListLogs(&model.LogFilter{Topics: topics, FromBlock: &next, ToBlock: &toNum})
+		if err != nil {
+			log.Printf("repo.ListLogs failed: %+v", err)
+			return
+		}
+		var transfers []*model.TokenTransfer
+		var nftTransfers []*model.NFTTransfer
+		for _, l := range logs {
+			if transfer, ok := model.NewTokenTransfer(l); ok {
+				transfers = append(transfers, transfer)
+			}
+			nftTransfers = append(nftTransfers, model.NewNFTTransfers(l)...)
+		}
+		if len(transfers) > 0 {
+			if err := s.repo.CreateTokenTransfers(transfers...); err != nil {
+				log.Printf("repo.CreateTokenTransfers failed: %+v", err)
+				return
+			}
+		}
+		if len(nftTransfers) > 0 {
+			if err := s.repo.CreateNFTTransfers(nftTransfers...); err != nil {
+				log.Printf("repo.CreateNFTTransfers failed: %+v", err)
+				return
+			}
+		}
+		if err := s.repo.SetCheckpoint(decodeLogsCheckpoint, toNum); err != nil {
+			log.Printf("repo.SetCheckpoint failed: %+v", err)
+			return
+		}
+		next = toNum + 1
+	}
+}
+
+func (s *service) SetContractABI(ctx context.Context, address, abiJSON string) error {
+	if _, err := abi.JSON(strings.NewReader(abiJSON)); err != nil {
+		return ErrInvalidABI
+	}
+	if err := s.repo.SetContractABI(address, abiJSON); err != nil {
+		log.Printf("repo.SetContractABI failed: %+v", err)
+		return err
+	}
+	return nil
+}
+
+// decodeTransactions decodes the calls and the events of txs with the ABIs
+// registered for the called contracts and the contracts emitting the events.
+// The ABIs of all of txs are read and parsed once.
+func (s *service) decodeTransactions(txs ...*model.Transaction) {
+	var addresses []string
+	seen := make(map[string]bool)
+	for _, tx := range txs {
+		if !seen[tx.ToAddr] {
+			seen[tx.ToAddr] = true
+			addresses = append(addresses, tx.ToAddr)
+		}
+		for _, l := range tx.Logs {
+			if !seen[l.Address] {
+				seen[l.Address] = true
+				addresses = append(addresses, l.Address)
+			}
+		}
+	}
+	if len(addresses) == 0 {
+		return
+	}
+	contractABIs, err := s.repo.ListContractABIs(addresses...)
+	if err != nil {
+		log.Printf("repo.ListContractABIs failed: %+v", err)
+		return
+	}
+
+	contracts := make(map[string]*abi.ABI, len(contractABIs))
+	for _, contractABI := range contractABIs {
+		contract, err := abi.JSON(strings.NewReader(contractABI.ABI))
+		if err != nil {
+			log.Printf("abi.JSON %s failed: %+v", contractABI.Address, err)
+			continue
+		}
+		contracts[contractABI.Address] = &contract
+	}
+	for _, tx := range txs {
+		if contract, ok := contracts[tx.ToAddr]; ok {
+			tx.Decoded = model.DecodeCall(contract, tx.Data)
+		}
+		for i := range tx.Logs {
+			if contract, ok := contracts[tx.Logs[i].Address]; ok {
+				tx.Logs[i].Decoded = model.DecodeEvent(contract, &tx.Logs[i])
+			}
+		}
+	}
+
+	s.decodeBySignatures(txs)
+}
+
+// decodeBySignatures lists the candidate decodings of the calls and events of
+// txs that no registered ABI decoded, by looking up their function selectors
+// and event topics in the signature database at once.
+func (s *service) decodeBySignatures(txs []*model.Transaction) {
+	var selectors, topics []string
+	seen := make(map[string]bool)
+	for _, tx := range txs {
+		if tx.Decoded == nil && len(tx.Data) >= 10 && !seen[tx.Data[:10]] {
+			seen[tx.Data[:10]] = true
+			selectors = append(selectors, tx.Data[:10])
+		}
+		for _, l := range tx.Logs {
+			if l.Decoded == nil && len(l.Topics) > 0 && !seen[l.Topics[0]] {
+				seen[l.Topics[0]] = true
+				topics = append(topics, l.Topics[0])
+			}
+		}
+	}
+	if len(selectors) == 0 && len(topics) == 0 {
+		return
+	}
+
+	signatures, err := s.repo.ListSignatures(selectors, topics)
+	if err != nil {
+		log.Printf("repo.ListSignatures failed: %+v", err)
+		return
+	}
+	// a signature hashes to a selector and a topic, so it is looked up by both
+	bySelector := make(map[string][]string)
+	byTopic := make(map[string][]string)
+	for _, sig := range signatures {
+		bySelector[sig.Selector] = append(bySelector[sig.Selector], sig.Signature)
+		byTopic[sig.Topic] = append(byTopic[sig.Topic], sig.Signature)
+	}
+	for _, tx := range txs {
+		if tx.Decoded == nil && len(tx.Data) >= 10 {
+			for _, sig := range bySelector[tx.Data[:10]] {
+				if decoded := model.DecodeCallBySignature(sig, tx.Data); decoded != nil {
+					tx.Candidates = append(tx.Candidates, decoded)
+				}
+			}
+		}
+		for i := range tx.Logs {
+			l := &tx.Logs[i]
+			if l.Decoded != nil || len(l.Topics) == 0 {
+				continue
+			}
+			for _, sig := range byTopic[l.Topics[0]] {
+				if decoded := model.DecodeEventBySignature(sig, l); decoded != nil {
+					l.Candidates = append(l.Candidates, decoded)
+				}
+			}
+		}
+	}
+}
+
+// ImportSignatures loads text signatures, one per line, into the signature
+// database. Lines may also start with the hex selector, as in 4byte dumps, and
+// blank lines and lines starting with # are skipped. It returns the number of
+// signatures read.
+func (s *service) ImportSignatures(ctx context.Context, r io.Reader) (int, error) {
+	var count int
+	var signatures []*model.Signature
+	flush := func() error {
+		if len(signatures) == 0 {
+			return nil
+		}
+		err := s.repo.CreateSignatures(signatures...)
+		signatures = signatures[:0]
+		return err
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if fields := strings.Fields(line); len(fields) == 2 && strings.HasPrefix(fields[0], "0x") {
+			line = fields[1]
+		}
+		sig, ok := model.NewSignature(line)
+		if !ok {
+			log.Printf("signature is invalid: %q", line)
+			continue
+		}
+		signatures = append(signatures, sig)
+		count++
+		if len(signatures) == signatureBatchSize {
+			if err := flush(); err != nil {
+				log.Printf("repo.CreateSignatures failed: %+v", err)
+				return count, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return count, err
+	}
+	if err := flush(); err != nil {
+		log.Printf("repo.CreateSignatures failed: %+v", err)
+		return count, err
+	}
+	return count, nil
+}
+
+func (s *service) GetInternalTransactions(ctx context.Context, txHash string) ([]*model.InternalTransaction, error) {
+	txs, err := s.repo.ListInternalTransactions(txHash)
+	if err != nil {
+		log.Printf("repo.ListInternalTransactions failed: %+v", err)
+		return nil, err
+	}
+	if len(txs) == 0 {
+		// tell an unknown transaction from one without internal transactions
+		if _, err := s.GetTransaction(ctx, txHash); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
+func (s *service) GetAddressInternalTransactions(ctx context.Context, filter *model.InternalTxFilter) ([]*model.InternalTransaction, error) {
+	if err := s.defaultRange(ctx, &filter.FromBlock, &filter.ToBlock); err != nil {
+		return nil, err
+	}
+
+	txs, err := s.repo.ListAddressInternalTransactions(filter)
+	if err != nil {
+		log.Printf("repo.ListAddressInternalTransactions failed: %+v", err)
+		return nil, err
+	}
+	return txs, nil
+}
+
+// RepairTransactions re-fetches transactions that were stored before their
+// full input data, sender, fee and signature fields were persisted. A pass
+// that fails to list or repair any of them is retried after repairInterval,
+// until every transaction is complete or missing from its block on the node.
+func (s *service) RepairTransactions(ctx context.Context) {
+	for !s.repairTransactions(ctx) {
+		time.Sleep(repairInterval)
+	}
+}
+
+// repairTransactions runs one pass over the incomplete transactions and
+// reports whether all of them were repaired.
+func (s *service) repairTransactions(ctx context.Context) bool {
+	var lastTxHash string
+	repaired := true
+	for {
+		txs, err := s.repo.ListIncompleteTransactions(lastTxHash, repairBatchSize)
+		if err != nil {
+			log.Printf("repo.ListIncompleteTransactions failed: %+v", err)
+			return false
+		}
+		if len(txs) == 0 {
+			return repaired
+		}
+		for _, tx := range txs {
+			err := s.repairTransaction(ctx, tx)
+			if err == ErrNotFound {
+				// another pass would not find it either
+				log.Printf("repairTransaction %s failed, skipping it: not in block %d", tx.TxHash, tx.BlockNum)
+				continue
+			}
+			if err != nil {
+				log.Printf("repairTransaction %s failed: %+v", tx.TxHash, err)
+				repaired = false
+			}
+		}
+		lastTxHash = txs[len(txs)-1].TxHash
+	}
+}
+
+func (s *service) repairTransaction(ctx context.Context, tx *model.Transaction) error {
+	txn, err := s.fetchTransaction(ctx, tx)
+	if err != nil {
+		return err
+	}
+	repaired, err := model.NewTransaction(txn, s.signer)
+	if err != nil {
+		return err
+	}
+	repaired.BlockNum = tx.BlockNum
+	repaired.Logs = tx.Logs
+	repaired.Receipt = tx.Receipt
+	*tx = *repaired
+	if err := s.repo.UpdateTransaction(tx); err != nil {
+		return err
+	}
+	return s.repo.DelTxCache(ctx, tx.TxHash)
+}
+
+// fetchTransaction fetches the stored tx from the node by its hash or, from a
+// node that no longer indexes it, out of its block. It returns ErrNotFound
+// when the block does not hold it either.
+func (s *service) fetchTransaction(ctx context.Context, tx *model.Transaction) (*types.Transaction, error) {
+	txn, _, err := s.ec.TransactionByHash(ctx, common.HexToHash(tx.TxHash))
+	if err != ethereum.NotFound {
+		return txn, err
+	}
+	block, err := s.ec.BlockByNumber(ctx, new(big.Int).SetUint64(tx.BlockNum))
+	if err == ethereum.NotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if txn := block.Transaction(common.HexToHash(tx.TxHash)); txn != nil {
+		return txn, nil
+	}
+	return nil, ErrNotFound
+}
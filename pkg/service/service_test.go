@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"Kumazan/go-ethereum-server/pkg/model"
+	"Kumazan/go-ethereum-server/pkg/node"
+	"Kumazan/go-ethereum-server/pkg/repo"
+)
+
+// fakeRepo stores the blocks up to head, the transactions of a block and the
+// ABIs and signatures to decode them, counting the lookups of the latter. Any
+// other query panics on the nil embedded Repo.
+type fakeRepo struct {
+	repo.Repo
+	head       uint64
+	txs        []*model.Transaction
+	abis       []*model.ContractABI
+	signatures []*model.Signature
+
+	abiLookups       int
+	signatureLookups int
+
+	checkpoints map[string]uint64
+	stored      []uint64
+}
+
+func (r *fakeRepo) GetCheckpoint(name string) (*model.Checkpoint, error) {
+	num, ok := r.checkpoints[name]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	return &model.Checkpoint{Name: name, BlockNum: num}, nil
+}
+
+func (r *fakeRepo) SetCheckpoint(name string, num uint64) error {
+	r.checkpoints[name] = num
+	return nil
+}
+
+func (r *fakeRepo) CreateBlocks(blocks ...*model.Block) ([]*model.Block, error) {
+	for _, block := range blocks {
+		r.stored = append(r.stored, block.BlockNum)
+	}
+	return nil, nil
+}
+
+func (r *fakeRepo) ListBlockTransactions(num uint64) ([]*model.Transaction, error) {
+	return r.txs, nil
+}
+
+func (r *fakeRepo) ListContractABIs(addresses ...string) ([]*model.ContractABI, error) {
+	r.abiLookups++
+	return r.abis, nil
+}
+
+func (r *fakeRepo) ListSignatures(selectors, topics []string) ([]*model.Signature, error) {
+	r.signatureLookups++
+	return r.signatures, nil
+}
+
+func (r *fakeRepo) ListBlocks(ctx context.Context, fromNum, toNum uint64) ([]*model.Block, error) {
+	blocks := []*model.Block{}
+	for num := min(toNum, r.head) + 1; num > fromNum; num-- {
+		blocks = append(blocks, &model.Block{BlockNum: num - 1})
+	}
+	return blocks, nil
+}
+
+func blockNums(blocks []*model.Block) []uint64 {
+	nums := []uint64{}
+	for _, block := range blocks {
+		nums = append(nums, block.BlockNum)
+	}
+	return nums
+}
+
+// numRange returns the numbers from to down to from.
+func numRange(to, from uint64) []uint64 {
+	nums := []uint64{}
+	for num := to + 1; num > from; num-- {
+		nums = append(nums, num-1)
+	}
+	return nums
+}
+
+func TestListLastestBlocksCursor(t *testing.T) {
+	// the finalized head is known, so the node is not asked for it
+	s := &service{repo: &fakeRepo{head: 105}, finalityLoaded: true, safeNum: 105, finalizedNum: 105}
+
+	tests := []struct {
+		name   string
+		cursor *model.Cursor
+		want   []uint64
+	}{
+		{"first page", nil, numRange(105, 96)},
+		{"page back", &model.Cursor{BlockNum: 96}, numRange(95, 86)},
+		{"last page back", &model.Cursor{BlockNum: 4}, numRange(3, 0)},
+		{"past the oldest", &model.Cursor{BlockNum: 0}, []uint64{}},
+		{"page forward", &model.Cursor{BlockNum: 86, Forward: true}, numRange(96, 87)},
+		{"page forward near the head", &model.Cursor{BlockNum: 99, Forward: true}, numRange(105, 100)},
+		{"past the newest", &model.Cursor{BlockNum: 105, Forward: true}, []uint64{}},
+		{"above the head", &model.Cursor{BlockNum: 200}, numRange(105, 96)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks, err := s.ListLastestBlocks(context.Background(), 10, model.FinalityFinalized, tt.cursor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := blockNums(blocks); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("blocks = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetBlockTransactionsDecoding(t *testing.T) {
+	token := common.HexToAddress("0x0a").String()
+	other := common.HexToAddress("0x0b").String()
+	erc20ABI := `[{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}]}]`
+	erc20, err := abi.JSON(strings.NewReader(erc20ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	transfer, err := erc20.Pack("transfer", common.HexToAddress("0x02"), big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	approve, ok := model.NewSignature("approve(address,uint256)")
+	if !ok {
+		t.Fatal("approve signature is invalid")
+	}
+	approval := hexutil.Encode(append(hexutil.MustDecode(approve.Selector), transfer[4:]...))
+
+	newTx := func(hash, to, data string) *model.Transaction {
+		return &model.Transaction{TxHash: hash, ToAddr: to, Data: data, Gas: 21000, Receipt: &model.Receipt{TxHash: hash}}
+	}
+	r := &fakeRepo{
+		txs: []*model.Transaction{
+			newTx("0x01", token, hexutil.Encode(transfer)),
+			newTx("0x02", token, hexutil.Encode(transfer)),
+			newTx("0x03", other, approval),
+			newTx("0x04", other, approval),
+		},
+		abis:       []*model.ContractABI{{Address: token, ABI: erc20ABI}},
+		signatures: []*model.Signature{approve},
+	}
+	s := &service{repo: r, finalityLoaded: true}
+	block := &model.Block{BlockNum: 1, TxHash: []string{"0x01", "0x02", "0x03", "0x04"}}
+
+	txs, err := s.GetBlockTransactions(context.Background(), block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.abiLookups != 1 || r.signatureLookups != 1 {
+		t.Errorf("looked up ABIs %d and signatures %d times, want once each", r.abiLookups, r.signatureLookups)
+	}
+	for _, tx := range txs[:2] {
+		if tx.Decoded == nil || len(tx.Candidates) != 0 {
+			t.Errorf("transaction %s decoded = %v, candidates %v, want the call decoded by the ABI", tx.TxHash, tx.Decoded, tx.Candidates)
+		}
+	}
+	for _, tx := range txs[2:] {
+		if tx.Decoded != nil || len(tx.Candidates) != 1 {
+			t.Errorf("transaction %s decoded = %v, candidates %v, want a candidate of the signature", tx.TxHash, tx.Decoded, tx.Candidates)
+		}
+	}
+}
+
+// fakeNode serves empty blocks up to head, failing the ones in failing.
+type fakeNode struct {
+	head    uint64
+	failing map[uint64]bool
+}
+
+func (n *fakeNode) GetBlockByNumber(num hexutil.Uint64, full bool) (*types.Header, error) {
+	if n.failing[uint64(num)] {
+		return nil, errors.New("block is unavailable")
+	}
+	if uint64(num) > n.head {
+		return nil, nil
+	}
+	return &types.Header{Number: new(big.Int).SetUint64(uint64(num)), Difficulty: common.Big0}, nil
+}
+
+// newTestService returns a service of the node and the repo, whose safe head
+// is safeNum.
+func newTestService(t *testing.T, n *fakeNode, r *fakeRepo, safeNum uint64) *service {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", n); err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+	ec, err := node.Dial([]string{ts.URL}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &service{
+		ec:             ec,
+		signer:         types.LatestSignerForChainID(big.NewInt(1)),
+		batchSize:      2,
+		concurrency:    1,
+		repo:           r,
+		finalityLoaded: true,
+		safeNum:        safeNum,
+	}
+}
+
+func TestBackfillStart(t *testing.T) {
+	tests := []struct {
+		name        string
+		checkpoints map[string]uint64
+		want        uint64
+	}{
+		{"first run", map[string]uint64{}, 10},
+		{"resumed run", map[string]uint64{backfillCheckpoint: 14}, 15},
+		{"resumed at the start", map[string]uint64{backfillCheckpoint: 10}, 11},
+		{"checkpoint below the start", map[string]uint64{backfillCheckpoint: 5}, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{repo: &fakeRepo{checkpoints: tt.checkpoints}}
+			got, err := s.backfillStart(10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("backfillStart = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackfillBatch(t *testing.T) {
+	n := &fakeNode{head: 300, failing: map[uint64]bool{}}
+	r := &fakeRepo{checkpoints: map[string]uint64{}}
+	s := newTestService(t, n, r, 150)
+	ctx := context.Background()
+
+	steps := []struct {
+		name       string
+		next       uint64
+		safeNum    uint64
+		failing    uint64
+		want       uint64
+		wantOK     bool
+		checkpoint uint64
+		stored     int
+	}{
+		{"full batch", 1, 150, 0, backfillBatchSize + 1, true, backfillBatchSize, backfillBatchSize},
+		{"up to the safe head", backfillBatchSize + 1, 150, 0, 151, true, 150, 150},
+		{"past the safe head", 151, 150, 0, 151, false, 150, 150},
+		{"failed batch", 151, 200, 170, 151, false, 150, 150},
+		{"retried batch", 151, 200, 0, 201, true, 200, 200},
+	}
+	for _, step := range steps {
+		s.safeNum = step.safeNum
+		n.failing = map[uint64]bool{step.failing: true}
+		next, ok := s.backfillBatch(ctx, step.next)
+		if next != step.want || ok != step.wantOK {
+			t.Errorf("%s: backfillBatch(%d) = %d, %v, want %d, %v", step.name, step.next, next, ok, step.want, step.wantOK)
+		}
+		// the checkpoint only moves past the blocks stored
+		if checkpoint := r.checkpoints[backfillCheckpoint]; checkpoint != step.checkpoint || len(r.stored) != step.stored {
+			t.Errorf("%s: checkpoint = %d with %d blocks stored, want %d with %d", step.name, checkpoint, len(r.stored), step.checkpoint, step.stored)
+		}
+	}
+	for i, num := range r.stored {
+		if num != uint64(i+1) {
+			t.Fatalf("stored blocks %v, want 1 to %d in order", r.stored, len(r.stored))
+		}
+	}
+
+	// a restarted backfill resumes after the checkpoint
+	if next, err := s.backfillStart(1); err != nil || next != 201 {
+		t.Errorf("backfillStart = %d, %v, want 201", next, err)
+	}
+}